@@ -0,0 +1,70 @@
+package gronx
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Result is the due of cron Expr for the reference time of BatchDue, or the error if any.
+type Result struct {
+	Expr string
+	Due  bool
+	Err  error
+}
+
+// BatchDue checks if each of cron exprs is due for ref like IsDue, with the results in the
+// order of exprs. The same expr is checked only once in the batch, and the exprs are checked
+// in parallel as per WithBatchWorkers. The invalid exprs don't fail the others; it returns
+// all the results along with error wrapping the first of their errors if any.
+func (g *Gronx) BatchDue(exprs []string, ref time.Time) ([]Result, error) {
+	results := make([]Result, len(exprs))
+	first := make(map[string]int, len(exprs))
+	unique := make([]int, 0, len(exprs))
+	for i, expr := range exprs {
+		results[i].Expr = expr
+		if _, ok := first[expr]; !ok {
+			first[expr] = i
+			unique = append(unique, i)
+		}
+	}
+
+	check := func(i int) {
+		results[i].Due, results[i].Err = g.IsDue(exprs[i], ref)
+	}
+	if workers := minInt(g.workers, len(unique)); workers > 1 {
+		var wg sync.WaitGroup
+		for w := 0; w < workers; w++ {
+			wg.Add(1)
+			go func(w int) {
+				defer wg.Done()
+				for n := w; n < len(unique); n += workers {
+					check(unique[n])
+				}
+			}(w)
+		}
+		wg.Wait()
+	} else {
+		for _, i := range unique {
+			check(i)
+		}
+	}
+
+	var err error
+	failed := 0
+	for i := range results {
+		if j := first[exprs[i]]; j != i {
+			results[i].Due, results[i].Err = results[j].Due, results[j].Err
+		}
+		if results[i].Err != nil {
+			if failed++; err == nil {
+				err = results[i].Err
+			}
+		}
+	}
+	if err != nil {
+		return results, fmt.Errorf("%d of %d exprs failed: %w", failed, len(exprs), err)
+	}
+
+	return results, nil
+}
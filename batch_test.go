@@ -0,0 +1,80 @@
+package gronx
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestBatchDue(t *testing.T) {
+	ref := time.Date(2024, time.June, 3, 9, 5, 0, 0, time.UTC)
+
+	t.Run("batch due order", func(t *testing.T) {
+		gron := New()
+		exprs := []string{"5 9 * * *", "61 * * * *", "*/5 * * * MON", "0 0 * * *", "5 9 * * *", "@every 5m", "* * * * MOM"}
+		expect := []bool{true, false, true, false, true, true, false}
+
+		results, err := gron.BatchDue(exprs, ref)
+		var ferr *FieldError
+		if !errors.As(err, &ferr) || !strings.HasPrefix(err.Error(), "2 of 7 exprs failed") {
+			t.Errorf("expected error wrapping FieldError for 2 exprs, got %v", err)
+		}
+		if len(results) != len(exprs) {
+			t.Fatalf("expected %d results, got %d", len(exprs), len(results))
+		}
+		for i, result := range results {
+			due, derr := gron.IsDue(exprs[i], ref)
+			if result.Expr != exprs[i] || result.Due != expect[i] || result.Due != due || (result.Err == nil) != (derr == nil) {
+				t.Errorf("%s: expected %v, %v, got %+v", exprs[i], due, derr, result)
+			}
+		}
+	})
+
+	t.Run("batch due valid", func(t *testing.T) {
+		gron := New()
+		results, err := gron.BatchDue([]string{"5 9 * * *", "6 9 * * *"}, ref)
+		if err != nil || len(results) != 2 || !results[0].Due || results[1].Due {
+			t.Errorf("expected [true false], got %+v, %v", results, err)
+		}
+		if results, err := gron.BatchDue(nil, ref); err != nil || len(results) != 0 {
+			t.Errorf("expected no results, got %+v, %v", results, err)
+		}
+	})
+
+	t.Run("batch due workers", func(t *testing.T) {
+		exprs := make([]string, 500)
+		for i := range exprs {
+			exprs[i] = fmt.Sprintf("%d %d * * %d", i%60, i%24, i%9)
+		}
+		serial, parallel := New(), New(WithBatchWorkers(4), WithCache(100))
+
+		expect, eerr := serial.BatchDue(exprs, ref)
+		actual, err := parallel.BatchDue(exprs, ref)
+		if eerr == nil || err == nil || eerr.Error() != err.Error() {
+			t.Errorf("expected %v, got %v", eerr, err)
+		}
+		for i := range expect {
+			if expect[i].Due != actual[i].Due || (expect[i].Err == nil) != (actual[i].Err == nil) {
+				t.Errorf("%s: expected %+v, got %+v", exprs[i], expect[i], actual[i])
+			}
+		}
+	})
+}
+
+func BenchmarkBatchDue(b *testing.B) {
+	exprs := make([]string, 5000)
+	for i := range exprs {
+		exprs[i] = fmt.Sprintf("%d %d * * MON-FRI", i%60, i%24)
+	}
+	ref := time.Date(2024, time.June, 3, 9, 5, 0, 0, time.UTC)
+
+	for name, gron := range map[string]Gronx{"serial": New(WithCache(len(exprs))), "parallel": New(WithCache(len(exprs)), WithBatchWorkers(8))} {
+		b.Run(name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_, _ = gron.BatchDue(exprs, ref)
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package gronx
+
+import "fmt"
+
+// FieldBounds describes what segment at a position allows, as enforced by the checks.
+type FieldBounds struct {
+	Pos  int
+	Name string // eg: day of month
+	// Min and Max are the values allowed, eg: 0-7 for week day as both 0 and 7 are sunday.
+	Min, Max int
+	// Names are the names with their values, including registered ones, if segment has them.
+	Names map[string]int
+	// Modifiers are the tokens allowed besides values, ranges, lists and steps, eg: L and W.
+	Modifiers []string
+}
+
+// fieldModifiers are the tokens allowed in segments by position, other than numbers and names.
+// The ? is due always, H needs hash key, R is random and ~ is random within range.
+var fieldModifiers = [][]string{
+	PosMinute:     {"?", "H", "R", "~"},
+	PosHour:       {"?", "H", "R", "~"},
+	PosDayOfMonth: {"?", "H", "R", "~", "L", "W", "LW"},
+	PosMonth:      {"?", "H", "R", "~"},
+	PosDayOfWeek:  {"?", "H", "R", "~", "L", "#"},
+	PosYear:       {"?"},
+	PosSecond:     {"?", "H", "R", "~"},
+}
+
+// Bounds gives the values, names and modifiers allowed in segment at given position (eg: PosMonth).
+// It is what validation enforces, with the names as registered at the time of call. It has
+// only Pos if the position is not one of the Pos constants.
+func Bounds(pos int) FieldBounds {
+	if pos < PosMinute || pos > PosSecond {
+		return FieldBounds{Pos: pos}
+	}
+
+	fb := FieldBounds{Pos: pos, Name: segmentNames[pos], Modifiers: append([]string{}, fieldModifiers[pos]...)}
+	fb.Min, fb.Max = valueBounds(pos)
+	switch pos {
+	case PosMonth:
+		fb.Names = nameMap(months)
+	case PosDayOfWeek:
+		fb.Names = nameMap(days)
+	}
+
+	return fb
+}
+
+// String gives the bounds like `month 1-12`.
+func (fb FieldBounds) String() string {
+	return fmt.Sprintf("%s %d-%d", fb.Name, fb.Min, fb.Max)
+}
+
+// nameMap copies the names for reading without lock.
+func nameMap(names map[string]int) map[string]int {
+	namesMu.RLock()
+	defer namesMu.RUnlock()
+
+	out := make(map[string]int, len(names))
+	for name, num := range names {
+		out[name] = num
+	}
+
+	return out
+}
+
+// letterModifiers gives the modifiers of segment at given position that are letters only,
+// which are not names.
+func letterModifiers(pos int) map[string]bool {
+	out := map[string]bool{}
+	for _, mod := range fieldModifiers[pos] {
+		if nameRe.MatchString(mod) {
+			out[mod] = true
+		}
+	}
+
+	return out
+}
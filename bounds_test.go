@@ -0,0 +1,59 @@
+package gronx
+
+import (
+	"strconv"
+	"testing"
+)
+
+func TestBounds(t *testing.T) {
+	tests := []struct {
+		pos      int
+		min, max int
+		names    int
+		mods     int
+	}{
+		{PosMinute, 0, 59, 0, 4},
+		{PosHour, 0, 23, 0, 4},
+		{PosDayOfMonth, 1, 31, 0, 7},
+		{PosMonth, 1, 12, 23, 4},
+		{PosDayOfWeek, 0, 7, 14, 6},
+		{PosYear, minYear, maxYear, 0, 1},
+		{PosSecond, 0, 59, 0, 4},
+	}
+
+	for _, test := range tests {
+		fb := Bounds(test.pos)
+		t.Run("bounds "+fb.String(), func(t *testing.T) {
+			if fb.Min != test.min || fb.Max != test.max || len(fb.Names) < test.names || len(fb.Modifiers) != test.mods {
+				t.Errorf("expected %d-%d with %d names and %d modifiers, got %+v", test.min, test.max, test.names, test.mods, fb)
+			}
+			if err := IsValidSegment(strconv.Itoa(fb.Min), fb.Pos); err != nil {
+				t.Errorf("expected min to be valid, got %v", err)
+			}
+			if err := IsValidSegment(strconv.Itoa(fb.Max), fb.Pos); err != nil {
+				t.Errorf("expected max to be valid, got %v", err)
+			}
+			if err := IsValidSegment(strconv.Itoa(fb.Max+1), fb.Pos); err == nil {
+				t.Errorf("expected max+1 to be invalid, got nil")
+			}
+			for name := range fb.Names {
+				if err := IsValidSegment(name, fb.Pos); err != nil {
+					t.Errorf("expected name %s to be valid, got %v", name, err)
+				}
+			}
+		})
+	}
+
+	t.Run("bounds names copy", func(t *testing.T) {
+		Bounds(PosMonth).Names["JAN"] = 5
+		if Bounds(PosMonth).Names["JAN"] != 1 {
+			t.Errorf("expected names to be copied")
+		}
+	})
+
+	t.Run("bounds unknown pos", func(t *testing.T) {
+		if fb := Bounds(9); fb.Pos != 9 || fb.Name != "" || fb.Max != 0 {
+			t.Errorf("expected empty bounds, got %+v", fb)
+		}
+	})
+}
@@ -0,0 +1,266 @@
+package gronx
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Builder builds cron expression field by field, eg:
+// NewBuilder().AtMinute(0, 30).BetweenHours(9, 17).OnWeekdays(time.Monday, time.Friday).
+// The fields not set are *. Setting a field again is an error told by Build, as is any
+// invalid value. It is not safe for concurrent use.
+type Builder struct {
+	opts   []Option
+	fields [PosSecond + 1]string
+	by     [PosSecond + 1]string
+	errs   []error
+}
+
+// NewBuilder gives Builder of cron expression to be checked as per given options if any.
+func NewBuilder(opts ...Option) *Builder {
+	return &Builder{opts: opts}
+}
+
+// set sets the field at given position to value by the method name, or the error if it is
+// already set or value is empty.
+func (b *Builder) set(pos int, by, value string) *Builder {
+	switch {
+	case b.by[pos] != "":
+		b.errs = append(b.errs, fmt.Errorf("%s conflicts with %s for %s", by, b.by[pos], segmentNames[pos]))
+		return b
+	case value == "":
+		b.errs = append(b.errs, fmt.Errorf("%s needs at least one value for %s", by, segmentNames[pos]))
+		return b
+	}
+
+	b.fields[pos], b.by[pos] = value, by
+
+	return b
+}
+
+// AtSecond sets the seconds field to given values (WithSecondsField).
+func (b *Builder) AtSecond(seconds ...int) *Builder {
+	return b.set(PosSecond, "AtSecond", joinInts(seconds))
+}
+
+// EverySeconds sets the seconds field to every step seconds (WithSecondsField).
+func (b *Builder) EverySeconds(step int) *Builder {
+	return b.set(PosSecond, "EverySeconds", "*/"+strconv.Itoa(step))
+}
+
+// AtMinute sets the minute field to given values.
+func (b *Builder) AtMinute(minutes ...int) *Builder {
+	return b.set(PosMinute, "AtMinute", joinInts(minutes))
+}
+
+// EveryMinute sets the minute field to every minute.
+func (b *Builder) EveryMinute() *Builder {
+	return b.set(PosMinute, "EveryMinute", "*")
+}
+
+// EveryMinutes sets the minute field to every step minutes.
+func (b *Builder) EveryMinutes(step int) *Builder {
+	return b.set(PosMinute, "EveryMinutes", "*/"+strconv.Itoa(step))
+}
+
+// BetweenMinutes sets the minute field to the range from and to (inclusive).
+func (b *Builder) BetweenMinutes(from, to int) *Builder {
+	return b.set(PosMinute, "BetweenMinutes", joinRange(from, to))
+}
+
+// AtHour sets the hour field to given values.
+func (b *Builder) AtHour(hours ...int) *Builder {
+	return b.set(PosHour, "AtHour", joinInts(hours))
+}
+
+// EveryHour sets the hour field to every hour.
+func (b *Builder) EveryHour() *Builder {
+	return b.set(PosHour, "EveryHour", "*")
+}
+
+// EveryHours sets the hour field to every step hours.
+func (b *Builder) EveryHours(step int) *Builder {
+	return b.set(PosHour, "EveryHours", "*/"+strconv.Itoa(step))
+}
+
+// BetweenHours sets the hour field to the range from and to (inclusive).
+func (b *Builder) BetweenHours(from, to int) *Builder {
+	return b.set(PosHour, "BetweenHours", joinRange(from, to))
+}
+
+// OnDaysOfMonth sets the day of month field to given values.
+func (b *Builder) OnDaysOfMonth(days ...int) *Builder {
+	return b.set(PosDayOfMonth, "OnDaysOfMonth", joinInts(days))
+}
+
+// BetweenDaysOfMonth sets the day of month field to the range from and to (inclusive).
+func (b *Builder) BetweenDaysOfMonth(from, to int) *Builder {
+	return b.set(PosDayOfMonth, "BetweenDaysOfMonth", joinRange(from, to))
+}
+
+// OnLastDayOfMonth sets the day of month field to the last day of month (L).
+func (b *Builder) OnLastDayOfMonth() *Builder {
+	return b.set(PosDayOfMonth, "OnLastDayOfMonth", "L")
+}
+
+// OnNearestWeekday sets the day of month field to the weekday nearest to day (eg: 15W).
+func (b *Builder) OnNearestWeekday(day int) *Builder {
+	return b.set(PosDayOfMonth, "OnNearestWeekday", strconv.Itoa(day)+"W")
+}
+
+// InMonths sets the month field to given months, by name.
+func (b *Builder) InMonths(months ...time.Month) *Builder {
+	names := make([]string, len(months))
+	for i, month := range months {
+		names[i] = monthName(month)
+	}
+
+	return b.set(PosMonth, "InMonths", strings.Join(names, ","))
+}
+
+// BetweenMonths sets the month field to the range from and to (inclusive), by name.
+func (b *Builder) BetweenMonths(from, to time.Month) *Builder {
+	return b.set(PosMonth, "BetweenMonths", monthName(from)+"-"+monthName(to))
+}
+
+// EveryMonths sets the month field to every step months.
+func (b *Builder) EveryMonths(step int) *Builder {
+	return b.set(PosMonth, "EveryMonths", "*/"+strconv.Itoa(step))
+}
+
+// OnWeekday sets the week day field to given days, by name.
+func (b *Builder) OnWeekday(days ...time.Weekday) *Builder {
+	names := make([]string, len(days))
+	for i, day := range days {
+		names[i] = dayName(day)
+	}
+
+	return b.set(PosDayOfWeek, "OnWeekday", strings.Join(names, ","))
+}
+
+// OnWeekdays sets the week day field to the range from and to (inclusive), by name, where
+// sunday ending the range is 7 as well.
+func (b *Builder) OnWeekdays(from, to time.Weekday) *Builder {
+	return b.set(PosDayOfWeek, "OnWeekdays", dayName(from)+"-"+dayName(to))
+}
+
+// OnLastWeekday sets the week day field to the last given day of month (eg: 5L).
+func (b *Builder) OnLastWeekday(day time.Weekday) *Builder {
+	return b.set(PosDayOfWeek, "OnLastWeekday", strconv.Itoa(int(day))+"L")
+}
+
+// OnNthWeekday sets the week day field to the nth given day of month (eg: 5#2).
+func (b *Builder) OnNthWeekday(day time.Weekday, n int) *Builder {
+	return b.set(PosDayOfWeek, "OnNthWeekday", strconv.Itoa(int(day))+"#"+strconv.Itoa(n))
+}
+
+// InYears sets the year field to given years.
+func (b *Builder) InYears(years ...int) *Builder {
+	return b.set(PosYear, "InYears", joinInts(years))
+}
+
+// BetweenYears sets the year field to the range from and to (inclusive).
+func (b *Builder) BetweenYears(from, to int) *Builder {
+	return b.set(PosYear, "BetweenYears", joinRange(from, to))
+}
+
+// Build gives the cron expression with 5 fields, or with year last and seconds first if they
+// are set, after validating it like Parse. It returns error if any field is set twice or the
+// expression is invalid.
+func (b *Builder) Build() (string, error) {
+	expr, _, err := b.build()
+
+	return expr, err
+}
+
+// Expression gives the compiled form of Build as per the options of Builder.
+func (b *Builder) Expression() (*Expression, error) {
+	_, e, err := b.build()
+
+	return e, err
+}
+
+func (b *Builder) build() (string, *Expression, error) {
+	if len(b.errs) > 0 {
+		return "", nil, b.errs[0]
+	}
+
+	fields := make([]string, 0, len(b.fields))
+	for _, field := range b.fields[:PosYear] {
+		fields = append(fields, orStar(field))
+	}
+
+	opts := append([]Option{}, b.opts...)
+	seconds, year := b.by[PosSecond] != "", b.by[PosYear] != ""
+	switch {
+	case seconds && year:
+		// Quartz wants ? for the day field not set if the other is set.
+		switch dom, dow := b.by[PosDayOfMonth] != "", b.by[PosDayOfWeek] != ""; {
+		case dom && !dow:
+			fields[PosDayOfWeek] = "?"
+		case dow && !dom:
+			fields[PosDayOfMonth] = "?"
+		}
+		opts = append(opts, WithQuartz())
+	case seconds:
+		opts = append(opts, WithSecondsField())
+	}
+	if seconds {
+		fields = append([]string{b.fields[PosSecond]}, fields...)
+	}
+	if year {
+		fields = append(fields, b.fields[PosYear])
+	}
+
+	expr, gron := strings.Join(fields, " "), New(opts...)
+	e, err := gron.Parse(expr)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return expr, e, nil
+}
+
+// joinInts joins nums as list of values.
+func joinInts(nums []int) string {
+	values := make([]string, len(nums))
+	for i, num := range nums {
+		values[i] = strconv.Itoa(num)
+	}
+
+	return strings.Join(values, ",")
+}
+
+// joinRange joins from and to as range.
+func joinRange(from, to int) string {
+	return strconv.Itoa(from) + "-" + strconv.Itoa(to)
+}
+
+// monthName gives the short name of month, or its number if it is not known.
+func monthName(month time.Month) string {
+	if month < time.January || month > time.December {
+		return strconv.Itoa(int(month))
+	}
+
+	return strings.ToUpper(month.String()[:3])
+}
+
+// dayName gives the short name of day, or its number if it is not known.
+func dayName(day time.Weekday) string {
+	if day < time.Sunday || day > time.Saturday {
+		return strconv.Itoa(int(day))
+	}
+
+	return strings.ToUpper(day.String()[:3])
+}
+
+// orStar gives field, or * if it is empty.
+func orStar(field string) string {
+	if field == "" {
+		return "*"
+	}
+
+	return field
+}
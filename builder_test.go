@@ -0,0 +1,68 @@
+package gronx
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestBuilder(t *testing.T) {
+	t.Run("builder build", func(t *testing.T) {
+		tests := map[string]*Builder{
+			"0,30 9-17 * * MON-FRI":   NewBuilder().AtMinute(0, 30).BetweenHours(9, 17).OnWeekdays(time.Monday, time.Friday),
+			"* */2 * * *":             NewBuilder().EveryMinute().EveryHours(2),
+			"*/15 * 1,15 JAN,JUL *":   NewBuilder().EveryMinutes(15).OnDaysOfMonth(1, 15).InMonths(time.January, time.July),
+			"0 0 L */3 *":             NewBuilder().AtMinute(0).AtHour(0).OnLastDayOfMonth().EveryMonths(3),
+			"0 9 15W MAR-OCT *":       NewBuilder().AtMinute(0).AtHour(9).OnNearestWeekday(15).BetweenMonths(time.March, time.October),
+			"0 0 * * 5L":              NewBuilder().AtMinute(0).AtHour(0).OnLastWeekday(time.Friday),
+			"0 0 * * 1#2":             NewBuilder().AtMinute(0).AtHour(0).OnNthWeekday(time.Monday, 2),
+			"0 0 * * SAT,SUN":         NewBuilder().AtMinute(0).AtHour(0).OnWeekday(time.Saturday, time.Sunday),
+			"0 0 1-7 * * 2030-2032":   NewBuilder().AtMinute(0).AtHour(0).BetweenDaysOfMonth(1, 7).BetweenYears(2030, 2032),
+			"0 0 1 JAN * 2030,2035":   NewBuilder().AtMinute(0).AtHour(0).OnDaysOfMonth(1).InMonths(time.January).InYears(2030, 2035),
+			"*/10 10-20 * * * *":      NewBuilder().EverySeconds(10).BetweenMinutes(10, 20),
+			"0 0 12 ? * FRI-SUN 2030": NewBuilder().AtSecond(0).AtMinute(0).AtHour(12).OnWeekdays(time.Friday, time.Sunday).InYears(2030),
+			"30 0 12 1 * ? 2030":      NewBuilder().AtSecond(30).AtMinute(0).AtHour(12).OnDaysOfMonth(1).InYears(2030),
+			"* * * * *":               NewBuilder(),
+		}
+		for expect, builder := range tests {
+			expr, err := builder.Build()
+			if err != nil || expr != expect {
+				t.Errorf("expected %s, got %s, %v", expect, expr, err)
+			}
+			e, err := builder.Expression()
+			if err != nil || e.String() != expect {
+				t.Errorf("expected compiled %s, got %v, %v", expect, e, err)
+			}
+		}
+	})
+
+	t.Run("builder invalid", func(t *testing.T) {
+		tests := map[string]*Builder{
+			"AtMinute conflicts with EveryMinute for minute": NewBuilder().EveryMinute().AtMinute(5),
+			"InYears conflicts with BetweenYears for year":   NewBuilder().BetweenYears(2030, 2031).InYears(2032),
+			"should be 0-59": NewBuilder().AtMinute(61),
+			"step":           NewBuilder().EveryHours(0),
+			"month":          NewBuilder().InMonths(time.Month(13)),
+			"AtHour needs at least one value for hour": NewBuilder().AtHour(),
+		}
+		for expect, builder := range tests {
+			if _, err := builder.Build(); err == nil || (strings.Contains(expect, " ") && !strings.Contains(err.Error(), expect)) {
+				t.Errorf("expected error %s, got %v", expect, err)
+			}
+			if e, err := builder.Expression(); err == nil || e != nil {
+				t.Errorf("expected error, got %v", e)
+			}
+		}
+	})
+
+	t.Run("builder options", func(t *testing.T) {
+		expr, err := NewBuilder(WithHashKey("job")).AtMinute(5).AtHour(9).Build()
+		if err != nil || expr != "5 9 * * *" {
+			t.Errorf("expected 5 9 * * *, got %s, %v", expr, err)
+		}
+		e, err := NewBuilder(WithLocation(time.UTC)).AtMinute(5).Expression()
+		if err != nil || !e.IsDue(time.Date(2024, time.June, 3, 9, 5, 0, 0, time.UTC)) {
+			t.Errorf("expected due, got %v", err)
+		}
+	})
+}
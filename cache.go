@@ -0,0 +1,94 @@
+package gronx
+
+import (
+	"container/list"
+	"sync"
+)
+
+// parseCache is the LRU cache of compiled expressions by expr as given, including the errors of
+// invalid ones. It is safe for concurrent use, and shared by the copies of Gronx.
+type parseCache struct {
+	mu    sync.Mutex
+	size  int
+	macro uint64
+	items map[string]*list.Element
+	order *list.List
+}
+
+// cacheEntry is the compiled expression of expr or its error.
+type cacheEntry struct {
+	expr string
+	e    *Expression
+	err  error
+}
+
+func newParseCache(size int) *parseCache {
+	return &parseCache{size: size, items: make(map[string]*list.Element, size), order: list.New()}
+}
+
+// get gets the entry of expr if cached, marking it as recently used. The cache is cleared when
+// macros are registered since it was filled, as they may change what expr means.
+func (c *parseCache) get(expr string) (cacheEntry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if version := macroVersion(); version != c.macro {
+		c.reset()
+		c.macro = version
+	}
+
+	item, ok := c.items[expr]
+	if !ok {
+		return cacheEntry{}, false
+	}
+	c.order.MoveToFront(item)
+
+	return item.Value.(cacheEntry), true
+}
+
+// put caches the entry, evicting the least recently used one when full.
+func (c *parseCache) put(entry cacheEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if item, ok := c.items[entry.expr]; ok {
+		item.Value = entry
+		c.order.MoveToFront(item)
+		return
+	}
+
+	c.items[entry.expr] = c.order.PushFront(entry)
+	if c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.items, oldest.Value.(cacheEntry).expr)
+	}
+}
+
+// len gives the count of cached entries.
+func (c *parseCache) len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.order.Len()
+}
+
+// clear removes all cached entries.
+func (c *parseCache) clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.reset()
+}
+
+func (c *parseCache) reset() {
+	c.items = make(map[string]*list.Element, c.size)
+	c.order.Init()
+}
+
+// ClearCache removes all the compiled expressions cached as per WithCache, if any.
+func (g *Gronx) ClearCache() {
+	if g.cache != nil {
+		g.cache.clear()
+	}
+}
@@ -0,0 +1,122 @@
+package gronx
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestWithCache(t *testing.T) {
+	ref := time.Date(2024, time.June, 3, 9, 5, 0, 0, time.UTC)
+
+	t.Run("cache hit", func(t *testing.T) {
+		gron, fresh := New(WithCache(8)), New()
+		for _, expr := range []string{"*/5 9-17 * * MON-FRI", "0 0 L * *", "@every 5m", "CRON_TZ=Asia/Tokyo 5 18 * * *"} {
+			for i := 0; i < 2; i++ {
+				due, err := gron.IsDue(expr, ref)
+				expect, eerr := fresh.IsDue(expr, ref)
+				if due != expect || err != eerr {
+					t.Errorf("%s: expected %v, %v, got %v, %v", expr, expect, eerr, due, err)
+				}
+				next, err := gron.GetNext(expr, ref)
+				if enext, _ := fresh.GetNext(expr, ref); err != nil || !next.Equal(*enext) {
+					t.Errorf("%s: expected %v, got %v, %v", expr, enext, next, err)
+				}
+			}
+		}
+		if n := gron.cache.len(); n != 4 {
+			t.Errorf("expected 4 cached, got %d", n)
+		}
+	})
+
+	t.Run("cache error", func(t *testing.T) {
+		gron, fresh := New(WithCache(8)), New()
+		_, first := gron.IsDue("0 0 * * MOM", ref)
+		_, second := gron.IsDue("0 0 * * MOM", ref)
+		if _, fresh := fresh.IsDue("0 0 * * MOM", ref); first == nil || first.Error() != fresh.Error() || second != first {
+			t.Errorf("expected cached %v, got %v and %v", fresh, first, second)
+		}
+		if n := gron.cache.len(); n != 1 {
+			t.Errorf("expected 1 cached, got %d", n)
+		}
+	})
+
+	t.Run("cache evict", func(t *testing.T) {
+		gron := New(WithCache(2))
+		for _, expr := range []string{"1 * * * *", "2 * * * *", "1 * * * *", "3 * * * *"} {
+			_, _ = gron.Parse(expr)
+		}
+		if _, ok := gron.cache.get("2 * * * *"); ok {
+			t.Errorf("expected least recently used evicted")
+		}
+		if _, ok := gron.cache.get("1 * * * *"); !ok || gron.cache.len() != 2 {
+			t.Errorf("expected recently used kept")
+		}
+	})
+
+	t.Run("cache clear", func(t *testing.T) {
+		gron := New(WithCache(2))
+		_, _ = gron.Parse("* * * * *")
+		gron.ClearCache()
+		if n := gron.cache.len(); n != 0 {
+			t.Errorf("expected cleared, got %d", n)
+		}
+
+		nocache := New(WithCache(0))
+		nocache.ClearCache()
+		if nocache.cache != nil {
+			t.Errorf("expected no cache")
+		}
+	})
+
+	t.Run("cache macro", func(t *testing.T) {
+		gron := New(WithCache(2))
+		abort(RegisterMacro("@cache-test", "0 9 * * *", true))
+		if due, _ := gron.IsDue("@cache-test", ref); due {
+			t.Errorf("expected not due at 09:05")
+		}
+		abort(RegisterMacro("@cache-test", "5 9 * * *", true))
+		if due, err := gron.IsDue("@cache-test", ref); err != nil || !due {
+			t.Errorf("expected due as per macro registered anew, got %v, %v", due, err)
+		}
+	})
+
+	t.Run("cache concurrent", func(t *testing.T) {
+		gron, wg := New(WithCache(4)), sync.WaitGroup{}
+		for i := 0; i < 8; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				for j := 0; j < 50; j++ {
+					expr := fmt.Sprintf("%d 9 * * *", (i+j)%8)
+					if due, err := gron.IsDue(expr, ref); err != nil || due != ((i+j)%8 == 5) {
+						t.Errorf("%s: expected due %v, got %v, %v", expr, (i+j)%8 == 5, due, err)
+					}
+				}
+				if i == 0 {
+					gron.ClearCache()
+				}
+			}(i)
+		}
+		wg.Wait()
+	})
+}
+
+func BenchmarkWithCache(b *testing.B) {
+	exprs := make([]string, 100)
+	for i := range exprs {
+		exprs[i] = fmt.Sprintf("%d %d * * MON-FRI", i%60, i%24)
+	}
+	ref := time.Date(2024, time.June, 3, 9, 5, 0, 0, time.UTC)
+
+	fresh, cached := New(), New(WithCache(len(exprs)))
+	for name, gron := range map[string]*Gronx{"fresh": &fresh, "cached": &cached} {
+		b.Run(name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				_, _ = gron.IsDue(exprs[i%len(exprs)], ref)
+			}
+		})
+	}
+}
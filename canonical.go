@@ -0,0 +1,255 @@
+package gronx
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// canonicalRe matches the segments made of values, ranges, steps and lists only, which have
+// canonical form. The others like L, W, #, ?, H, R and ~ are kept as is.
+var canonicalRe = regexp.MustCompile(`^[\d*,/-]+$`)
+
+// Canonicalize rewrites cron expr into its canonical form that is due at the same times, so
+// that exprs can be compared, shown consistently and stored by it. The names are replaced and each segment
+// of values is rewritten as the values it is due for: sorted, without duplicates, with adjacent
+// values as range, evenly stepped values as step if shorter and the whole segment as * (eg: `5,1,5,3,4`
+// becomes `1,3-5`, `0-59` and `*/1` become `*`) except the hour, which becomes `0-23` so that it is
+// due as per DST rules like the restricted hour is. The macros are expanded, * year dropped, spaces
+// collapsed and TZ= prefix written as CRON_TZ= with the name of zone. It is idempotent, and the exprs
+// with the same canonical form are due at the same times. It doesn't change what IsDue and others see.
+// It returns the expr or error if it is invalid.
+func Canonicalize(expr string) (string, error) {
+	return canonicalize(expr, false, false)
+}
+
+// Canonicalize rewrites cron expr into its canonical form like Canonicalize, with @weekly
+// expanded as per WithWeekStart, the names of month and week day as per WithCanonicalNames, and
+// the day of month and week day kept restricted as per WithDayOr.
+func (g *Gronx) Canonicalize(expr string) (string, error) {
+	if _, ok := g.weeklyDay(expr); ok {
+		expr = g.Macros()["@weekly"]
+	}
+
+	return canonicalize(expr, g.dayOr, g.canonicalNames)
+}
+
+// canonicalize rewrites cron expr into its canonical form like Canonicalize, keeping both day of
+// month and week day restricted if they are as per dayOr, with names of month and week day if names.
+func canonicalize(expr string, dayOr, names bool) (string, error) {
+	expr, loc, err := exprZone(expr)
+	if err != nil {
+		return "", err
+	}
+	prefix := ""
+	if loc != nil {
+		prefix = "CRON_TZ=" + loc.String() + " "
+	}
+
+	expr = strings.Join(strings.Fields(expr), " ")
+	if _, ok, err := parseEvery(expr); ok {
+		if err != nil {
+			return "", err
+		}
+		return prefix + expr, nil
+	}
+
+	if err := Validate(expr); err != nil {
+		return "", err
+	}
+	segs, err := Segments(expr)
+	if errors.Is(err, ErrReboot) {
+		return prefix + "@reboot", nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	out := canonicalSegments(segs, dayOr)
+	if names {
+		for pos := range out {
+			out[pos] = namedSegment(out[pos], pos)
+		}
+	}
+	if len(out) > PosYear && out[PosYear] == "*" {
+		out = out[:PosYear]
+	}
+
+	return prefix + strings.Join(out, " "), nil
+}
+
+// canonicalSegments gives the canonical form of each of segs, keeping both day of month and week
+// day restricted or not as they are if dayOr.
+func canonicalSegments(segs []string, dayOr bool) []string {
+	restricted := dayOr && dayRestricted(segs)
+	out := make([]string, len(segs))
+	for pos, seg := range segs {
+		day := pos == PosDayOfMonth || pos == PosDayOfWeek
+		out[pos] = canonicalSegment(seg, pos, restricted && day)
+	}
+	if dayOr && !restricted && dayRestricted(out) {
+		// The day segment starting with * must stay so, eg: */27 is not 1,28 (WithDayOr).
+		for _, pos := range []int{PosDayOfMonth, PosDayOfWeek} {
+			if strings.HasPrefix(segs[pos], "*") {
+				out[pos] = starSegment(segs[pos], pos)
+			}
+		}
+	}
+	restrictHour(segs, out)
+
+	return out
+}
+
+// restrictHour keeps the hour of out restricted if it is in segs, as it is due at fixed wall
+// clock times as per DST rules then, eg: 0-23 and */1 are not * (wallFixed).
+func restrictHour(segs, out []string) {
+	if wallFixed(segs) && out[PosHour] == "*" {
+		out[PosHour] = "0-23"
+	}
+}
+
+// canonicalSegment gives the canonical form of segment at given position if it has values only.
+// If restricted, the form doesn't start with * so that the segment stays restricted (WithDayOr).
+func canonicalSegment(segment string, pos int, restricted bool) string {
+	if !canonicalRe.MatchString(segment) {
+		return segment
+	}
+
+	min, max := valueBounds(pos)
+	if pos == PosDayOfWeek {
+		// The 7 is sunday, same as 0.
+		max = 6
+	}
+
+	values := segmentValues(segment, pos)
+	switch {
+	case len(values) == 0:
+		return segment
+	case len(values) == max-min+1 && !restricted:
+		return "*"
+	}
+
+	list := valueRanges(values)
+	if step, ok := evenStep(values); ok {
+		first, last := values[0], values[len(values)-1]
+		stepped := strconv.Itoa(first) + "-" + strconv.Itoa(last) + "/" + strconv.Itoa(step)
+		if pos != PosYear && first == min && last+step > max && !restricted {
+			stepped = "*/" + strconv.Itoa(step)
+		}
+		if len(stepped) < len(list) {
+			return stepped
+		}
+	}
+
+	return list
+}
+
+// starSegment gives the canonical form of segment at given position that starts with *, ie
+// * with step if its values are stepped from the min, or else segment as is.
+func starSegment(segment string, pos int) string {
+	min, max := valueBounds(pos)
+	if pos == PosDayOfWeek {
+		max = 6
+	}
+
+	values, step := segmentValues(segment, pos), max-min+1
+	if len(values) > 1 {
+		step = values[1] - values[0]
+	}
+	for i, val := range values {
+		if val != min+i*step {
+			return segment
+		}
+	}
+	if len(values) == 0 || values[len(values)-1]+step <= max {
+		return segment
+	}
+	if step == 1 {
+		return "*"
+	}
+
+	return "*/" + strconv.Itoa(step)
+}
+
+// valueRe matches the value and the ends of range in offset, but not step.
+var valueRe = regexp.MustCompile(`(^|-)\d+`)
+
+// namedSegment gives the canonical segment at given position with the values of month or week
+// day as their names (eg: 1-5 becomes MON-FRI), where steps and the list elements with L, W or #
+// are kept as numbers.
+func namedSegment(segment string, pos int) string {
+	if pos != PosMonth && pos != PosDayOfWeek {
+		return segment
+	}
+
+	offsets := strings.Split(segment, ",")
+	for i, offset := range offsets {
+		if !canonicalRe.MatchString(offset) {
+			continue
+		}
+		offsets[i] = valueRe.ReplaceAllStringFunc(offset, func(value string) string {
+			sep := strings.TrimRight(value, "0123456789")
+			num, _ := strconv.Atoi(value[len(sep):])
+			if pos == PosMonth {
+				return sep + monthName(time.Month(num))
+			}
+			return sep + dayName(time.Weekday(num))
+		})
+	}
+
+	return strings.Join(offsets, ",")
+}
+
+// offsetsDue checks if any offset of segment at given position is due for val, the same as
+// checking it for due time does.
+func offsetsDue(segment string, pos, val int) bool {
+	min, max := bounds[pos][0], bounds[pos][1]
+	for _, offset := range strings.Split(segment, ",") {
+		if due, _ := isOffsetDue(offset, val, min, max); due {
+			return true
+		}
+		if due, _ := isOffsetDue(offset, 7, min, max); due && pos == PosDayOfWeek && val == 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// evenStep gives the step between sorted values if there are at least 3 of them with the same
+// step other than 1.
+func evenStep(values []int) (int, bool) {
+	if len(values) < 3 {
+		return 0, false
+	}
+
+	step := values[1] - values[0]
+	for i := 2; i < len(values); i++ {
+		if values[i]-values[i-1] != step {
+			return 0, false
+		}
+	}
+
+	return step, step > 1
+}
+
+// valueRanges gives the list of sorted values with adjacent ones as range, eg: 1,3-5.
+func valueRanges(values []int) string {
+	parts := []string{}
+	for i := 0; i < len(values); {
+		j := i
+		for j+1 < len(values) && values[j+1] == values[j]+1 {
+			j++
+		}
+
+		part := strconv.Itoa(values[i])
+		if j > i {
+			part += "-" + strconv.Itoa(values[j])
+		}
+		parts, i = append(parts, part), j+1
+	}
+
+	return strings.Join(parts, ",")
+}
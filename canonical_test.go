@@ -0,0 +1,215 @@
+package gronx
+
+import (
+	"math/rand"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCanonicalize(t *testing.T) {
+	tests := map[string]string{
+		"5,1,5,3 * * * *":            "1,3,5 * * * *",
+		"5,1,5,3,4 * * * *":          "1,3-5 * * * *",
+		"0-59 * * * *":               "* * * * *",
+		"*/1 */1 * * *":              "* 0-23 * * *",
+		"0,15,30,45 * * * *":         "*/15 * * * *",
+		"5-55/10 0-23 1-31 1-12 0-6": "5-55/10 0-23 * * *",
+		"0 22-2 * * *":               "0 0-2,22-23 * * *",
+		"0 0 * jan-mar,feb mon-fri":  "0 0 * 1-3 1-5",
+		"0 0 * * 5-7":                "0 0 * * 0,5-6",
+		"0 0 * * 7":                  "0 0 * * 0",
+		"0 0 L * 5L":                 "0 0 L * 5L",
+		"0 0 ? * 1#2":                "0 0 ? * 1#2",
+		"0 0 1 1 * *":                "0 0 1 1 *",
+		"0 0 1 1 * 2025,2026,2024":   "0 0 1 1 * 2024-2026",
+		"0 0 1 1 * 2090/3":           "0 0 1 1 * 2090-2099/3",
+		"@daily":                     "0 0 * * *",
+		"@reboot":                    "@reboot",
+		"@every 1h":                  "@every 1h",
+		" 0  0 *\t* * ":              "0 0 * * *",
+		"@every  1h30m":              "@every 1h30m",
+		"TZ=Asia/Tokyo  0 0 * * *":   "CRON_TZ=Asia/Tokyo 0 0 * * *",
+		"CRON_TZ=UTC @daily":         "CRON_TZ=UTC 0 0 * * *",
+	}
+
+	for expr, expect := range tests {
+		t.Run("canonicalize "+expr, func(t *testing.T) {
+			actual, err := Canonicalize(expr)
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if actual != expect {
+				t.Errorf("expected %s, got %s", expect, actual)
+			}
+		})
+	}
+
+	t.Run("canonicalize invalid", func(t *testing.T) {
+		for _, expr := range []string{"61 * * * *", "CRON_TZ=Mars/Base 0 0 * * *", "@every 1x"} {
+			if _, err := Canonicalize(expr); err == nil {
+				t.Errorf("expected error for %s, got nil", expr)
+			}
+		}
+	})
+}
+
+// randomOffset gives a random value, range or either with step within min and max.
+func randomOffset(rnd *rand.Rand, min, max int) string {
+	a, b := min+rnd.Intn(max-min+1), min+rnd.Intn(max-min+1)
+	switch rnd.Intn(5) {
+	case 0:
+		return strconv.Itoa(a)
+	case 1:
+		return strconv.Itoa(a) + "-" + strconv.Itoa(b)
+	case 2:
+		return strconv.Itoa(a) + "-" + strconv.Itoa(b) + "/" + strconv.Itoa(1+rnd.Intn(4))
+	case 3:
+		return "*/" + strconv.Itoa(1+rnd.Intn(max-min+1))
+	}
+
+	return "*/1"
+}
+
+func TestCanonicalizeOccurrences(t *testing.T) {
+	rnd := rand.New(rand.NewSource(69))
+	ref := time.Date(2024, time.February, 20, 0, 0, 0, 0, time.UTC)
+	limits := [][2]int{{0, 59}, {0, 23}, {1, 31}, {1, 12}, {0, 7}}
+
+	for i := 0; i < 200; i++ {
+		segs := make([]string, len(limits))
+		for pos, limit := range limits {
+			offsets := make([]string, 1+rnd.Intn(3))
+			for j := range offsets {
+				offsets[j] = randomOffset(rnd, limit[0], limit[1])
+			}
+			segs[pos] = strings.Join(offsets, ",")
+		}
+		if rnd.Intn(2) == 0 {
+			segs[PosDayOfMonth] = "*"
+		}
+
+		expr := strings.Join(segs, " ")
+		canon, err := Canonicalize(expr)
+		if err != nil {
+			// The random ranges can wrap around where it is not allowed.
+			continue
+		}
+
+		t.Run("canonical occurrences "+expr, func(t *testing.T) {
+			if again, err := Canonicalize(canon); err != nil || again != canon {
+				t.Errorf("expected %s again, got %s (%v)", canon, again, err)
+			}
+			for j := 0; j < 50; j++ {
+				at := ref.Add(time.Duration(rnd.Intn(366*24*60)) * time.Minute)
+				due, err1 := IsDue(expr, at)
+				cdue, err2 := IsDue(canon, at)
+				if err1 != nil || err2 != nil || due != cdue {
+					t.Fatalf("%s at %v: expected %v like %s, got %v (%v, %v)", canon, at, due, expr, cdue, err1, err2)
+				}
+			}
+
+			next, err1 := NextTick(expr, ref)
+			cnext, err2 := NextTick(canon, ref)
+			if (err1 == nil) != (err2 == nil) || !next.Equal(cnext) {
+				t.Errorf("%s: expected next %v like %s, got %v (%v, %v)", canon, next, expr, cnext, err1, err2)
+			}
+		})
+	}
+}
+
+func TestCanonicalizeDST(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	abort(err)
+	gron := New()
+	windows := [][2]time.Time{
+		{time.Date(2024, time.November, 3, 0, 0, 0, 0, loc), time.Date(2024, time.November, 3, 4, 0, 0, 0, loc)},
+		{time.Date(2024, time.March, 10, 0, 0, 0, 0, loc), time.Date(2024, time.March, 10, 4, 0, 0, 0, loc)},
+	}
+
+	for _, expr := range []string{"0 0-23 * * *", "*/30 0-23 * * *", "0 */1 * * *", "15 0-23 1-31 * *"} {
+		t.Run("canonical dst "+expr, func(t *testing.T) {
+			canon, err := Canonicalize(expr)
+			if err != nil || !strings.Contains(canon, " 0-23 ") {
+				t.Fatalf("expected restricted hour, got %s (%v)", canon, err)
+			}
+			for _, window := range windows {
+				count, err1 := gron.CountOccurrences(expr, window[0], window[1])
+				ccount, err2 := gron.CountOccurrences(canon, window[0], window[1])
+				if err1 != nil || err2 != nil || count != ccount {
+					t.Errorf("%s from %v: expected %d like %s, got %d (%v, %v)", canon, window[0], count, expr, ccount, err1, err2)
+				}
+			}
+		})
+	}
+}
+
+func TestGronxCanonicalize(t *testing.T) {
+	tests := []struct {
+		opts   []Option
+		expr   string
+		expect string
+	}{
+		{[]Option{WithCanonicalNames()}, "0 0 * 1-3,12 mon-fri", "0 0 * JAN-MAR,DEC MON-FRI"},
+		{[]Option{WithCanonicalNames()}, "0 0 * */2 5-7", "0 0 * */2 SUN,FRI-SAT"},
+		{[]Option{WithCanonicalNames()}, "0 0 * * 7L", "0 0 * * 7L"},
+		{[]Option{WithCanonicalNames(), WithWeekStart(time.Monday)}, "@weekly", "0 0 * * MON"},
+		{[]Option{WithDayOr()}, "0 0 1-31 * mon", "0 0 1-31 * 1"},
+		{[]Option{WithDayOr()}, "0 0 1-31/2 * 0-6", "0 0 1-31/2 * 0-6"},
+		{[]Option{WithDayOr()}, "0 0 1-31/2 * *", "0 0 */2 * *"},
+		{[]Option{WithDayOr()}, "0 0 */27 * 1-5", "0 0 */27 * 1-5"},
+		{nil, "0 0 1-31/2 * 0-6", "0 0 */2 * *"},
+		{nil, "0 0 */27 * 1-5", "0 0 1,28 * 1-5"},
+	}
+
+	for _, test := range tests {
+		t.Run("canonicalize "+test.expr, func(t *testing.T) {
+			gron := New(test.opts...)
+			actual, err := gron.Canonicalize(test.expr)
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if actual != test.expect {
+				t.Errorf("expected %s, got %s", test.expect, actual)
+			}
+			if again, err := gron.Canonicalize(actual); err != nil || again != actual {
+				t.Errorf("expected %s again, got %s (%v)", actual, again, err)
+			}
+		})
+	}
+}
+
+func TestGronxCanonicalizeOccurrences(t *testing.T) {
+	rnd := rand.New(rand.NewSource(93))
+	ref := time.Date(2024, time.February, 20, 0, 0, 0, 0, time.UTC)
+	limits := [][2]int{{0, 59}, {0, 23}, {1, 31}, {1, 12}, {0, 7}}
+	gron := New(WithDayOr(), WithCanonicalNames())
+
+	for i := 0; i < 200; i++ {
+		segs := make([]string, len(limits))
+		for pos, limit := range limits {
+			segs[pos] = randomOffset(rnd, limit[0], limit[1])
+		}
+
+		expr := strings.Join(segs, " ")
+		canon, err := gron.Canonicalize(expr)
+		if err != nil {
+			continue
+		}
+
+		t.Run("canonical occurrences "+expr, func(t *testing.T) {
+			if again, err := gron.Canonicalize(canon); err != nil || again != canon {
+				t.Errorf("expected %s again, got %s (%v)", canon, again, err)
+			}
+			for j := 0; j < 50; j++ {
+				at := ref.Add(time.Duration(rnd.Intn(366*24*60)) * time.Minute)
+				due, err1 := gron.IsDue(expr, at)
+				cdue, err2 := gron.IsDue(canon, at)
+				if err1 != nil || err2 != nil || due != cdue {
+					t.Fatalf("%s at %v: expected %v like %s, got %v (%v, %v)", canon, at, due, expr, cdue, err1, err2)
+				}
+			}
+		})
+	}
+}
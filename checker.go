@@ -1,48 +1,102 @@
 package gronx
 
 import (
+	"fmt"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 )
 
-// Checker is interface for cron segment due check.
+// Checker is interface for cron segment due check. It is given the reference time, so it
+// holds no state and is safe for concurrent use as long as its implementation is.
 type Checker interface {
+	CheckDue(segment string, pos int, ref time.Time) (bool, error)
+}
+
+// SegmentChecker is factory implementation of Checker.
+type SegmentChecker struct{}
+
+// CheckDue checks if the cron segment at given position is due for ref.
+// It returns bool or error if any.
+func (c *SegmentChecker) CheckDue(segment string, pos int, ref time.Time) (bool, error) {
+	return checkDue(segment, pos, ref)
+}
+
+// RefChecker is the former interface of Checker which holds the reference time set by SetRef.
+//
+// Deprecated: Implement Checker instead, or adapt it with FromRefChecker.
+type RefChecker interface {
 	GetRef() time.Time
 	SetRef(ref time.Time)
 	CheckDue(segment string, pos int) (bool, error)
 }
 
-// SegmentChecker is factory implementation of Checker.
-type SegmentChecker struct {
-	ref time.Time
+// FromRefChecker adapts RefChecker into Checker, setting the reference time before each check
+// under lock so that the state of c is not raced on by concurrent checks.
+//
+// Deprecated: Implement Checker instead.
+func FromRefChecker(c RefChecker) Checker {
+	return &refChecker{c: c}
 }
 
-// GetRef returns the current reference time
-func (c *SegmentChecker) GetRef() time.Time {
-	return c.ref
+type refChecker struct {
+	mu sync.Mutex
+	c  RefChecker
 }
 
-// SetRef sets the reference time for which to check if a cron expression is due.
-func (c *SegmentChecker) SetRef(ref time.Time) {
-	c.ref = ref
+func (r *refChecker) CheckDue(segment string, pos int, ref time.Time) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.c.SetRef(ref)
+
+	return r.c.CheckDue(segment, pos)
 }
 
-// CheckDue checks if the cron segment at given position is due.
-// It returns bool or error if any.
-func (c *SegmentChecker) CheckDue(segment string, pos int) (bool, error) {
-	ref := c.GetRef()
-	val, loc := valueByPos(ref, pos), ref.Location()
-	last := time.Date(ref.Year(), ref.Month(), 1, 0, 0, 0, 0, loc).AddDate(0, 1, 0).Add(-time.Nanosecond).Day()
+// checkDue checks if the cron segment at given position is due for ref without any state.
+// The error if any is FieldError.
+func checkDue(segment string, pos int, ref time.Time) (bool, error) {
+	if pos < PosMinute || pos > PosSecond {
+		return false, fieldErr(pos, segment, ReasonSyntax, posErr(pos))
+	}
 
-	for _, offset := range strings.Split(segment, ",") {
+	due, err := checkOffsets(segment, pos, ref)
+	if err != nil {
+		reason := ReasonSyntax
+		if (pos == PosDayOfMonth || pos == PosDayOfWeek) && strings.ContainsAny(segment, "LW#") {
+			reason = ReasonModifier
+		}
+		return due, fieldErr(pos, segment, reason, err)
+	}
+
+	return due, nil
+}
+
+// checkOffsets checks if any offset of the cron segment at given position is due for ref.
+func checkOffsets(segment string, pos int, ref time.Time) (bool, error) {
+	val, min, max := valueByPos(ref, pos), 0, 0
+	if pos < len(bounds) {
+		min, max = bounds[pos][0], bounds[pos][1]
+	}
+	last := monthDays(ref.Year(), ref.Month())
+
+	offsets := strings.Split(segment, ",")
+	for i, offset := range offsets {
 		mod := pos == 2 || pos == 4
-		due, err := c.isOffsetDue(offset, val)
+		due, err := isOffsetDue(offset, val, min, max)
+		if !due && err == nil && pos == 4 && val == 0 {
+			// Sunday is 7 too.
+			due, err = isOffsetDue(offset, 7, min, max)
+		}
 
 		if due || (!mod && err != nil) {
-			return due, err
+			return due, elementErr(offsets, i, err)
 		}
 		if mod && !strings.ContainsAny(offset, "LW#") {
+			if err != nil {
+				return false, elementErr(offsets, i, err)
+			}
 			continue
 		}
 
@@ -52,23 +106,33 @@ func (c *SegmentChecker) CheckDue(segment string, pos int) (bool, error) {
 			due, err = isValidWeekDay(offset, last, ref)
 		}
 		if due || err != nil {
-			return due, err
+			return due, elementErr(offsets, i, err)
 		}
 	}
 
 	return false, nil
 }
 
-func (c *SegmentChecker) isOffsetDue(offset string, val int) (bool, error) {
+// posErr is the error for segment position that is not one of the Pos constants.
+func posErr(pos int) error {
+	return fmt.Errorf("invalid segment position %d, should be %d-%d", pos, PosMinute, PosSecond)
+}
+
+// elementErr tells which element of the list of offsets is invalid if there are many.
+func elementErr(offsets []string, i int, err error) error {
+	if err == nil || len(offsets) < 2 {
+		return err
+	}
+
+	return fmt.Errorf("invalid list element #%d %q in %q: %w", i, offsets[i], strings.Join(offsets, ","), err)
+}
+
+func isOffsetDue(offset string, val, min, max int) (bool, error) {
 	if strings.Contains(offset, "/") {
-		return inStep(val, offset)
+		return inStep(val, min, max, offset)
 	}
 	if strings.Contains(offset, "-") {
-		return inRange(val, offset)
-	}
-
-	if val == 0 || offset == "0" {
-		return offset == "0" && val == 0, nil
+		return inRange(val, min, max, offset)
 	}
 
 	nval, err := strconv.Atoi(offset)
@@ -93,6 +157,8 @@ func valueByPos(ref time.Time, pos int) int {
 		return int(ref.Weekday())
 	case 5:
 		return ref.Year()
+	case 6:
+		return ref.Second()
 	}
 
 	return 0
@@ -0,0 +1,151 @@
+package gronx
+
+import (
+	"container/heap"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// Collision is the instant At that the cron exprs by Indexes are all due at.
+type Collision struct {
+	At      time.Time
+	Indexes []int
+}
+
+// Collisions gets the instants between start and end (both inclusive) that at least minCount
+// (and at least 2) of cron exprs are due at, like Gronx.Collisions. It returns error if any
+// of exprs is invalid.
+func Collisions(exprs []string, start, end time.Time, minCount int) ([]Collision, error) {
+	for _, expr := range exprs {
+		if err := Validate(expr); err != nil {
+			return nil, err
+		}
+	}
+
+	gron := New()
+
+	return gron.Collisions(exprs, start, end, minCount)
+}
+
+// Collisions gets the instants between start and end (both inclusive) that at least minCount
+// (and at least 2) of cron exprs are due at in ascending order, each with the indexes of exprs
+// due at it in ascending order. The due times of exprs are merged as they are found one after
+// another like OccurrencesBetween, where the exprs in different zones (CRON_TZ=) are due at the
+// same instant if their wall clocks differ, and the instants are given in the location of start.
+// If there are more than MaxOccurrences collisions, it returns those collected so far along
+// with error. It returns error if any of exprs is invalid.
+func (g *Gronx) Collisions(exprs []string, start, end time.Time, minCount int) ([]Collision, error) {
+	if minCount < 2 {
+		minCount = 2
+	}
+
+	cursors, err := g.dueCursors(exprs, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	collisions := []Collision{}
+	for len(cursors) >= minCount {
+		at, due := cursors[0].at, []*dueCursor{}
+		for len(cursors) > 0 && cursors[0].at.Equal(at) {
+			due = append(due, heap.Pop(&cursors).(*dueCursor))
+		}
+
+		if len(due) >= minCount {
+			if len(collisions) == MaxOccurrences {
+				return collisions, fmt.Errorf("more than %d collisions between %s and %s", MaxOccurrences, start, end)
+			}
+
+			indexes := make([]int, len(due))
+			for i, c := range due {
+				indexes[i] = c.index
+			}
+			sort.Ints(indexes)
+			collisions = append(collisions, Collision{At: at.In(start.Location()), Indexes: indexes})
+		}
+
+		for _, c := range due {
+			ok, err := c.advance(c.at.Add(c.e.span()))
+			if err != nil {
+				return collisions, err
+			}
+			if ok {
+				heap.Push(&cursors, c)
+			}
+		}
+	}
+
+	return collisions, nil
+}
+
+// dueCursors gives min heap of the next due times of cron exprs on or after the minute (or
+// second, as per expr) of start until end, leaving out the ones not due by then.
+func (g *Gronx) dueCursors(exprs []string, start, end time.Time) (dueCursors, error) {
+	cursors := make(dueCursors, 0, len(exprs))
+	for i, expr := range exprs {
+		e, err := g.Parse(expr)
+		if err != nil {
+			return nil, err
+		}
+
+		c := &dueCursor{index: i, e: e, check: g.check(e), end: end}
+		ok, err := c.advance(ceilTick(inZone(start, e.loc), e.span()))
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			cursors = append(cursors, c)
+		}
+	}
+	heap.Init(&cursors)
+
+	return cursors, nil
+}
+
+// dueCursor is the next due time at of the compiled cron expr by index, until end.
+type dueCursor struct {
+	index int
+	e     *Expression
+	check dueFunc
+	end   time.Time
+	at    time.Time
+}
+
+// advance moves the cursor to the next due time on or after ref. It returns false if there is
+// none until end.
+func (c *dueCursor) advance(ref time.Time) (bool, error) {
+	if ref.After(c.end) {
+		return false, nil
+	}
+
+	next, ok, err := c.e.nextUntil(ref, c.end, c.check)
+	c.at = next
+
+	return ok, err
+}
+
+// dueCursors is min heap of dueCursor by due time, then by index.
+type dueCursors []*dueCursor
+
+func (h dueCursors) Len() int { return len(h) }
+
+func (h dueCursors) Less(i, j int) bool {
+	if h[i].at.Equal(h[j].at) {
+		return h[i].index < h[j].index
+	}
+
+	return h[i].at.Before(h[j].at)
+}
+
+func (h dueCursors) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *dueCursors) Push(x interface{}) { *h = append(*h, x.(*dueCursor)) }
+
+func (h *dueCursors) Pop() interface{} {
+	old := *h
+	c := old[len(old)-1]
+	*h = old[:len(old)-1]
+
+	return c
+}
@@ -0,0 +1,163 @@
+package gronx
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCollisions(t *testing.T) {
+	start := time.Date(2026, time.October, 14, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		exprs    []string
+		end      time.Time
+		minCount int
+		expect   []string
+	}{
+		{
+			[]string{"*/20 * * * *", "*/30 * * * *", "0 * * * *"}, start.Add(90 * time.Minute), 2,
+			[]string{"00:00 [0 1 2]", "01:00 [0 1 2]"},
+		},
+		{
+			[]string{"*/20 * * * *", "*/30 * * * *", "*/15 * * * *"}, start.Add(time.Hour), 2,
+			[]string{"00:00 [0 1 2]", "00:30 [1 2]", "01:00 [0 1 2]"},
+		},
+		{
+			[]string{"*/20 * * * *", "*/30 * * * *", "*/15 * * * *"}, start.Add(time.Hour), 3,
+			[]string{"00:00 [0 1 2]", "01:00 [0 1 2]"},
+		},
+		{
+			[]string{"0 9 * * *", "CRON_TZ=Asia/Kolkata 30 14 * * *", "CRON_TZ=America/New_York 0 5 * * *"}, start.AddDate(0, 0, 1), 2,
+			[]string{"09:00 [0 1 2]"},
+		},
+		{
+			[]string{"0 0 * * *", "0 12 * * *"}, start.AddDate(0, 0, 7), 2,
+			nil,
+		},
+		{
+			[]string{"* * * * *", "5 0 * * *"}, start.Add(10 * time.Minute), 0,
+			[]string{"00:05 [0 1]"},
+		},
+		{
+			[]string{"* * * * *"}, start.Add(10 * time.Minute), 1,
+			nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run("collisions "+strings.Join(test.exprs, " | "), func(t *testing.T) {
+			collisions, err := Collisions(test.exprs, start, test.end, test.minCount)
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			actual := []string{}
+			for _, c := range collisions {
+				if c.At.Location() != time.UTC {
+					t.Errorf("expected %v, got %v", time.UTC, c.At.Location())
+				}
+				actual = append(actual, fmt.Sprintf("%s %v", c.At.Format("15:04"), c.Indexes))
+			}
+			if fmt.Sprint(actual) != fmt.Sprint(test.expect) {
+				t.Errorf("expected %v, got %v", test.expect, actual)
+			}
+		})
+	}
+
+	t.Run("collisions invalid", func(t *testing.T) {
+		if _, err := Collisions([]string{"* * * * *", "* * * *"}, start, start.Add(time.Hour), 2); err == nil {
+			t.Error("expected error")
+		}
+	})
+
+	t.Run("collisions seconds", func(t *testing.T) {
+		gron := New(WithSecondsField())
+		collisions, err := gron.Collisions([]string{"*/20 * * * * *", "*/30 * * * * *"}, start, start.Add(time.Minute), 2)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if len(collisions) != 2 || collisions[1].At.Second() != 0 || collisions[1].At.Minute() != 1 {
+			t.Errorf("expected 2 collisions, got %v", collisions)
+		}
+
+		collisions, err = gron.Collisions([]string{"*/10 * * * * *", "*/15 * * * * *", "@every 90s"}, start.Add(time.Second), start.Add(90*time.Second), 2)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		actual := []string{}
+		for _, c := range collisions {
+			actual = append(actual, fmt.Sprintf("%s %v", c.At.Format("04:05"), c.Indexes))
+		}
+		if expect := "[00:30 [0 1] 01:00 [0 1] 01:30 [0 1 2]]"; fmt.Sprint(actual) != expect {
+			t.Errorf("expected %v, got %v", expect, actual)
+		}
+	})
+
+	t.Run("collisions too many", func(t *testing.T) {
+		end := start.Add(time.Duration(MaxOccurrences+10) * time.Minute)
+		collisions, err := Collisions([]string{"* * * * *", "* * * * *"}, start, end, 2)
+		if err == nil {
+			t.Error("expected error")
+		}
+		if len(collisions) != MaxOccurrences {
+			t.Errorf("expected %d, got %d", MaxOccurrences, len(collisions))
+		}
+	})
+}
+
+func TestCollisionsDue(t *testing.T) {
+	rnd := rand.New(rand.NewSource(98))
+	zones := []string{"", "CRON_TZ=UTC ", "CRON_TZ=Asia/Kolkata ", "CRON_TZ=America/New_York "}
+	segments := [][]string{
+		{"*/5", "*/15", "0,30", "7", "*/10"},
+		{"*", "*/2", "9-17", "0", "3,15"},
+		{"*", "*/2", "1-15"},
+		{"*"},
+		{"*", "1-5", "0,6"},
+	}
+	gron := New()
+	start := time.Date(2026, time.March, 7, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 0, 3)
+
+	for i := 0; i < 20; i++ {
+		exprs := make([]string, 2+rnd.Intn(3))
+		for j := range exprs {
+			fields := make([]string, len(segments))
+			for pos, values := range segments {
+				fields[pos] = values[rnd.Intn(len(values))]
+			}
+			exprs[j] = zones[rnd.Intn(len(zones))] + strings.Join(fields, " ")
+		}
+		minCount := 2 + rnd.Intn(len(exprs)-1)
+
+		t.Run("collisions due "+strings.Join(exprs, " | "), func(t *testing.T) {
+			collisions, err := gron.Collisions(exprs, start, end, minCount)
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			expect := []string{}
+			for ref := start; !ref.After(end); ref = ref.Add(time.Minute) {
+				indexes := []int{}
+				for j, expr := range exprs {
+					if due, _ := gron.IsDue(expr, ref); due {
+						indexes = append(indexes, j)
+					}
+				}
+				if len(indexes) >= minCount {
+					expect = append(expect, fmt.Sprintf("%s %v", ref.Format(dateFormat), indexes))
+				}
+			}
+
+			actual := []string{}
+			for _, c := range collisions {
+				actual = append(actual, fmt.Sprintf("%s %v", c.At.Format(dateFormat), c.Indexes))
+			}
+			if fmt.Sprint(actual) != fmt.Sprint(expect) {
+				t.Errorf("expected %v, got %v", expect, actual)
+			}
+		})
+	}
+}
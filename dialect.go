@@ -0,0 +1,120 @@
+package gronx
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Dialect is the grammar of cron expr that Gronx accepts.
+type Dialect int
+
+const (
+	// DialectDefault is the permissive grammar with all the extensions, like macros and modifiers.
+	DialectDefault Dialect = iota
+	// DialectPOSIX is the grammar of POSIX crontab, ie 5 segments of numbers, ranges and lists.
+	DialectPOSIX
+)
+
+func (d Dialect) String() string {
+	switch d {
+	case DialectDefault:
+		return "default"
+	case DialectPOSIX:
+		return "POSIX"
+	}
+
+	return "unknown"
+}
+
+// every parses @every expr like parseEvery, which is invalid in dialects without it.
+func (g *Gronx) every(expr string) (time.Duration, bool, error) {
+	every, ok, err := parseEvery(expr)
+	if ok && g.dialect == DialectPOSIX {
+		return 0, true, kindErr(ErrDialect, "@every is not allowed in POSIX cron: "+strings.Trim(expr, " \t"))
+	}
+
+	return every, ok, err
+}
+
+// dialectErrs gives the errors of constructs of expr that the dialect of Gronx doesn't allow.
+// It is a single error without position if expr as a whole is not allowed.
+func (g *Gronx) dialectErrs(expr string) []error {
+	if g.dialect != DialectPOSIX {
+		return nil
+	}
+
+	expr = strings.Trim(expr, " \t")
+	if strings.HasPrefix(expr, "@") {
+		return []error{kindErr(ErrDialect, "macro is not allowed in POSIX cron: "+expr)}
+	}
+
+	fields := SpaceRe.Split(expr, -1)
+	if len(fields) != 5 {
+		return []error{kindErr(ErrDialect, "POSIX cron expr should contain 5 segments, without seconds or year: "+expr)}
+	}
+
+	errs := []error{}
+	for pos, field := range fields {
+		if construct := posixConstruct(strings.ToUpper(field), pos); construct != "" {
+			err := fmt.Errorf("%s is not allowed in %s segment of POSIX cron: %s", construct, segmentNames[pos], field)
+			errs = append(errs, fieldErr(pos, field, ReasonDialect, err))
+		}
+	}
+
+	return errs
+}
+
+// posixConstruct tells the construct in segment at given position that POSIX crontab doesn't
+// have, if any. It has only *, numbers, ranges that don't wrap around and lists of them.
+func posixConstruct(segment string, pos int) string {
+	switch name := nameRe.FindString(segment); {
+	case strings.Contains(segment, "/"):
+		return "step"
+	case strings.Contains(segment, "?"):
+		return "?"
+	case strings.Contains(segment, "#"):
+		return "nth week day #"
+	case strings.Contains(segment, "~"):
+		return "random range ~"
+	case nameField(name) != "":
+		return fmt.Sprintf("name %q", name)
+	case name != "":
+		return fmt.Sprintf("modifier %q", name)
+	}
+
+	if offsets := strings.Split(segment, ","); len(offsets) > 1 {
+		for _, offset := range offsets {
+			if offset == "*" {
+				return "* in list"
+			}
+		}
+	}
+
+	return rangeConstruct(segment, pos)
+}
+
+// rangeConstruct tells if numeric segment at given position has range wrapping around or week
+// day 7, which cron implementations other than this don't have.
+func rangeConstruct(segment string, pos int) string {
+	for _, offset := range strings.Split(segment, ",") {
+		values := strings.Split(strings.Split(offset, "/")[0], "-")
+		nums := make([]int, 0, 2)
+		for _, value := range values {
+			if num, err := strconv.Atoi(value); err == nil {
+				nums = append(nums, num)
+			}
+		}
+		if len(nums) == 2 && nums[0] > nums[1] {
+			return "range wrapping around"
+		}
+		for _, num := range nums {
+			if pos == PosDayOfWeek && num == 7 {
+				return "week day 7"
+			}
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,87 @@
+package gronx
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWithDialect(t *testing.T) {
+	posix := New(WithDialect(DialectPOSIX))
+	for _, expr := range []string{"* * * * *", "0 0 1 1 0", "0,30 9-17 * * 1-5", "59 23 31 12 6"} {
+		t.Run("dialect posix "+expr, func(t *testing.T) {
+			if err := posix.Validate(expr); err != nil {
+				t.Errorf("expected no error, got %v", err)
+			}
+		})
+	}
+
+	tests := map[string]string{
+		"@daily":          "macro is not allowed",
+		"@reboot":         "macro is not allowed",
+		"@every 1h":       "@every is not allowed",
+		"0 0 * * * 2025":  "should contain 5 segments",
+		"*/5 * * * *":     "step is not allowed in minute segment",
+		"0 0 * JAN *":     `name "JAN" is not allowed in month segment`,
+		"0 0 * * MON-FRI": `name "MON" is not allowed in week day segment`,
+		"0 0 L * *":       `modifier "L" is not allowed in day of month segment`,
+		"0 0 15W * *":     `modifier "W" is not allowed`,
+		"0 0 * * 5#2":     "nth week day # is not allowed",
+		"0 0 ? * *":       "? is not allowed",
+		"H * * * *":       `modifier "H" is not allowed`,
+		"0~30 * * * *":    "random range ~ is not allowed",
+		"0 22-2 * * *":    "range wrapping around is not allowed",
+		"0 0 * * 7":       "week day 7 is not allowed",
+		"0 0 * * 5-7":     "week day 7 is not allowed",
+		"0 0 * * 1,*":     "* in list is not allowed",
+		"61 * * * *":      "value 61 in minute segment should be 0-59",
+	}
+	for expr, expect := range tests {
+		t.Run("dialect posix err "+expr, func(t *testing.T) {
+			err := posix.Validate(expr)
+			if err == nil || !strings.Contains(err.Error(), expect) {
+				t.Errorf("expected %s, got %v", expect, err)
+			}
+			if posix.IsValid(expr) {
+				t.Errorf("expected false, got true")
+			}
+		})
+	}
+
+	t.Run("dialect posix errors", func(t *testing.T) {
+		err := posix.Validate("*/5 0 L JAN *")
+		var verrs ValidationErrors
+		if !errors.As(err, &verrs) || len(verrs) != 3 || !errors.Is(err, ErrDialect) {
+			t.Errorf("expected 3 dialect errors, got %v", err)
+		}
+
+		var ferr *FieldError
+		if !errors.As(err, &ferr) || ferr.Reason != ReasonDialect || ferr.Pos != PosMinute {
+			t.Errorf("expected dialect FieldError at minute, got %v", err)
+		}
+		if _, err := posix.IsDue("@hourly"); !errors.Is(err, ErrDialect) {
+			t.Errorf("expected ErrDialect, got %v", err)
+		}
+		if _, err := posix.GetNext("@every 1m"); !errors.Is(err, ErrDialect) {
+			t.Errorf("expected ErrDialect, got %v", err)
+		}
+	})
+
+	t.Run("dialect posix day or", func(t *testing.T) {
+		ref, _ := time.Parse(dateFormat, "2021-04-16 00:00:00")
+		if due, err := posix.IsDue("0 0 13 * 5", ref); err != nil || !due {
+			t.Errorf("expected true on friday, got %v, %v", due, err)
+		}
+	})
+
+	t.Run("dialect default", func(t *testing.T) {
+		gron := New(WithDialect(DialectDefault))
+		if err := gron.Validate("*/5 0 L JAN *"); err != nil {
+			t.Errorf("expected no error, got %v", err)
+		}
+		if DialectPOSIX.String() != "POSIX" || Dialect(9).String() != "unknown" {
+			t.Errorf("expected POSIX and unknown, got %v and %v", DialectPOSIX, Dialect(9))
+		}
+	})
+}
@@ -0,0 +1,122 @@
+package gronx
+
+import "time"
+
+// wallClock gives the wall clock time of ref as if it was in UTC, which exists even if it is
+// skipped in the location of ref, for checking segments against.
+func wallClock(ref time.Time) time.Time {
+	return time.Date(ref.Year(), ref.Month(), ref.Day(), ref.Hour(), ref.Minute(), ref.Second(), ref.Nanosecond(), time.UTC)
+}
+
+// wallFixed checks if segs are due at fixed wall clock times, ie hour is restricted, which
+// follow DST rules. The others like */30 * * * * are due by the interval regardless of DST.
+func wallFixed(segs []string) bool {
+	return segs[PosHour] != "*" && segs[PosHour] != "?"
+}
+
+// dstDue adjusts due of ref for segs as per DST rules if they are due at fixed wall clock times.
+func dstDue(segs []string, ref time.Time, due bool, fn dueFunc) (bool, error) {
+	step := tick(segs)
+	if ref = truncTick(ref, step); due {
+		return !repeatedWall(segs, ref), nil
+	}
+
+	return gapDue(segs, ref, step, fn)
+}
+
+// gapDue checks if ref is the first instant after DST gap, where clocks jump forward (eg: from
+// 01:59 to 03:00), and any wall clock time skipped by the gap is due for segs. Those are due at
+// that instant instead, only once, where step is the smallest unit of time segs can be due for.
+// It is false for segs that are not due at fixed wall clock times.
+func gapDue(segs []string, ref time.Time, step time.Duration, due dueFunc) (bool, error) {
+	if !wallFixed(segs) {
+		return false, nil
+	}
+
+	before := ref.Add(-step)
+	_, off := ref.Zone()
+	if _, prevOff := before.Zone(); off <= prevOff {
+		return false, nil
+	}
+
+	for wall, end := wallClock(before).Add(step), wallClock(ref); wall.Before(end); wall = wall.Add(step) {
+		if pos, err := undueSegment(segs, wall, due); pos < 0 || err != nil {
+			return err == nil, err
+		}
+	}
+
+	return false, nil
+}
+
+// repeatedWall checks if ref is the second time of its wall clock due to DST fall-back, where
+// clocks go back (eg: from 01:59 to 01:00), so segs due at fixed wall clock times are not due
+// as they were already due the first time.
+func repeatedWall(segs []string, ref time.Time) bool {
+	_, ok := firstInstant(ref)
+
+	return ok && wallFixed(segs)
+}
+
+// firstInstant gives the first instant with the same wall clock as ref if ref is the second
+// one due to DST fall-back, along with whether it is. The offset a day before ref is taken as
+// the one before fall-back.
+func firstInstant(ref time.Time) (time.Time, bool) {
+	_, off := ref.Zone()
+	_, dayOff := ref.Add(-24 * time.Hour).Zone()
+	if dayOff <= off {
+		return ref, false
+	}
+
+	first := ref.Add(-time.Duration(dayOff-off) * time.Second)
+	if _, firstOff := first.Zone(); firstOff != dayOff {
+		return ref, false
+	}
+
+	return first, true
+}
+
+// dayStart gives the start of given day in loc like time.Date, but the first instant of it if
+// midnight is repeated due to DST fall-back, so the day is not cut short, or skipped by DST gap.
+func dayStart(year int, month time.Month, day int, loc *time.Location) time.Time {
+	start, _ := firstInstant(wallTime(year, month, day, 0, loc))
+
+	return start
+}
+
+// wallTime gives the time at the start of given hour in loc like time.Date, but the first
+// instant after DST gap if the wall clock time is skipped by it, where time.Date would give
+// the one before the gap, possibly on the previous day.
+func wallTime(year int, month time.Month, day, hour int, loc *time.Location) time.Time {
+	t := time.Date(year, month, day, hour, 0, 0, 0, loc)
+	if wallClock(t).Equal(time.Date(year, month, day, hour, 0, 0, 0, time.UTC)) {
+		return t
+	}
+
+	_, before := t.Zone()
+	_, after := t.Add(24 * time.Hour).Zone()
+
+	return t.Add(time.Duration(after-before) * time.Second)
+}
+
+// gapBetween finds the first instant after DST gap strictly between from and to, which are
+// a multiple of step apart. It returns the instant and whether there is such gap, where only
+// one change of zone offset between them is looked for.
+func gapBetween(from, to time.Time, step time.Duration) (time.Time, bool) {
+	_, fromOff := from.Zone()
+	if _, toOff := to.Zone(); toOff <= fromOff {
+		return to, false
+	}
+
+	lo, hi := 0, int(to.Sub(from)/step)
+	for hi-lo > 1 {
+		mid := lo + (hi-lo)/2
+		if _, off := from.Add(time.Duration(mid) * step).Zone(); off == fromOff {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	gap := from.Add(time.Duration(hi) * step)
+
+	return gap, gap.Before(to)
+}
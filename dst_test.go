@@ -0,0 +1,254 @@
+package gronx
+
+import (
+	"testing"
+	"time"
+)
+
+type DSTCase struct {
+	Zone   string
+	Expr   string
+	Ref    string
+	Expect string
+}
+
+func TestSpringForward(t *testing.T) {
+	gron := New()
+
+	t.Run("spring forward next", func(t *testing.T) {
+		tests := []DSTCase{
+			{"America/New_York", "30 2 * * *", "2024-03-10 00:00:00", "2024-03-10 03:00:00"},
+			{"America/New_York", "30 2 * * *", "2024-03-10 03:01:00", "2024-03-11 02:30:00"},
+			{"America/New_York", "30 2 * * *", "2025-03-09 01:59:00", "2025-03-09 03:00:00"},
+			{"America/New_York", "*/15 2 * * *", "2025-03-09 01:00:00", "2025-03-09 03:00:00"},
+			{"America/New_York", "30 2 9 3 *", "2025-03-01 00:00:00", "2025-03-09 03:00:00"},
+			{"America/New_York", "0 3 * * *", "2024-03-10 00:00:00", "2024-03-10 03:00:00"},
+			{"America/Chicago", "59 2 * * *", "2024-03-10 00:00:00", "2024-03-10 03:00:00"},
+			{"Europe/Berlin", "30 2 * * *", "2024-03-31 00:00:00", "2024-03-31 03:00:00"},
+			{"Europe/Berlin", "30 2 * * *", "2025-03-30 00:00:00", "2025-03-30 03:00:00"},
+			{"Europe/London", "30 1 * * *", "2024-03-31 00:00:00", "2024-03-31 02:00:00"},
+			{"Europe/London", "30 1 * * *", "2025-03-30 00:00:00", "2025-03-30 02:00:00"},
+			{"America/New_York", "30 2 * * *", "2024-07-10 00:00:00", "2024-07-10 02:30:00"},
+		}
+
+		for _, test := range tests {
+			t.Run("spring forward next "+test.Zone+" "+test.Expr+" "+test.Ref, func(t *testing.T) {
+				loc, err := time.LoadLocation(test.Zone)
+				abort(err)
+				ref, _ := time.ParseInLocation(dateFormat, test.Ref, loc)
+
+				next, err := gron.GetNext(test.Expr, ref)
+				if err != nil || next.Format(dateFormat) != test.Expect {
+					t.Errorf("expected %s, got %v, %v", test.Expect, next, err)
+				}
+				if tick, err := NextTick(test.Expr, ref); err != nil || tick.Format(dateFormat) != test.Expect {
+					t.Errorf("expected stateless %s, got %v, %v", test.Expect, tick, err)
+				}
+			})
+		}
+	})
+
+	t.Run("spring forward prev", func(t *testing.T) {
+		tests := []DSTCase{
+			{"America/New_York", "30 2 * * *", "2024-03-10 12:00:00", "2024-03-10 03:00:00"},
+			{"America/New_York", "30 2 * * *", "2024-03-10 03:00:00", "2024-03-10 03:00:00"},
+			{"America/New_York", "30 2 * * *", "2024-03-10 01:59:00", "2024-03-09 02:30:00"},
+			{"America/New_York", "*/15 2 * * *", "2025-03-09 05:00:00", "2025-03-09 03:00:00"},
+			{"America/New_York", "30 1 * * *", "2024-03-10 04:00:00", "2024-03-10 01:30:00"},
+			{"America/New_York", "30 1 10 3 *", "2024-03-10 04:00:00", "2024-03-10 01:30:00"},
+			{"America/Havana", "0 0 10 3 *", "2024-03-10 04:00:00", "2024-03-10 01:00:00"},
+			{"America/Havana", "59 23 9 3 *", "2024-03-10 04:00:00", "2024-03-09 23:59:00"},
+			{"Europe/Berlin", "30 2 * * *", "2025-03-30 03:30:00", "2025-03-30 03:00:00"},
+			{"Europe/London", "30 1 * * *", "2024-03-31 23:00:00", "2024-03-31 02:00:00"},
+		}
+
+		for _, test := range tests {
+			t.Run("spring forward prev "+test.Zone+" "+test.Expr+" "+test.Ref, func(t *testing.T) {
+				loc, err := time.LoadLocation(test.Zone)
+				abort(err)
+				ref, _ := time.ParseInLocation(dateFormat, test.Ref, loc)
+
+				prev, err := gron.GetPrev(test.Expr, ref)
+				if err != nil || prev.Format(dateFormat) != test.Expect {
+					t.Errorf("expected %s, got %v, %v", test.Expect, prev, err)
+				}
+				if tick, err := PrevTick(test.Expr, ref); err != nil || tick.Format(dateFormat) != test.Expect {
+					t.Errorf("expected stateless %s, got %v, %v", test.Expect, tick, err)
+				}
+			})
+		}
+	})
+
+	t.Run("spring forward at midnight", func(t *testing.T) {
+		legacy := Gronx{C: FromRefChecker(&legacyChecker{})}
+		tests := []DSTCase{
+			{"America/Havana", "0 12 * * 0", "2024-03-09 13:00:00", "2024-03-10 12:00:00"},
+			{"America/Havana", "0 12 10 3 *", "2024-03-09 13:00:00", "2024-03-10 12:00:00"},
+			{"America/Havana", "0 0 * * *", "2024-03-09 13:00:00", "2024-03-10 01:00:00"},
+			{"America/Havana", "30 0 10 3 *", "2024-01-09 13:00:00", "2024-03-10 01:00:00"},
+			{"America/Santiago", "0 12 * * 0", "2024-09-07 13:00:00", "2024-09-08 12:00:00"},
+			{"America/Santiago", "0 12 8 9 *", "2024-09-07 13:00:00", "2024-09-08 12:00:00"},
+			{"America/Santiago", "0 0 * * *", "2024-09-07 13:00:00", "2024-09-08 01:00:00"},
+		}
+
+		for _, test := range tests {
+			t.Run("spring forward at midnight "+test.Zone+" "+test.Expr+" "+test.Ref, func(t *testing.T) {
+				loc, err := time.LoadLocation(test.Zone)
+				abort(err)
+				ref, _ := time.ParseInLocation(dateFormat, test.Ref, loc)
+
+				if next, err := gron.GetNext(test.Expr, ref); err != nil || next.Format(dateFormat) != test.Expect {
+					t.Errorf("expected %s, got %v, %v", test.Expect, next, err)
+				}
+				if tick, err := NextTick(test.Expr, ref); err != nil || tick.Format(dateFormat) != test.Expect {
+					t.Errorf("expected stateless %s, got %v, %v", test.Expect, tick, err)
+				}
+				if next, err := legacy.GetNext(test.Expr, ref); err != nil || next.Format(dateFormat) != test.Expect {
+					t.Errorf("expected custom checker %s, got %v, %v", test.Expect, next, err)
+				}
+			})
+		}
+	})
+
+	t.Run("spring forward is due", func(t *testing.T) {
+		loc, err := time.LoadLocation("America/New_York")
+		abort(err)
+
+		tests := map[string]bool{"2024-03-10 03:00:00": true, "2024-03-10 03:00:59": true, "2024-03-10 03:01:00": false, "2024-03-10 01:59:00": false}
+		for at, expect := range tests {
+			ref, _ := time.ParseInLocation(dateFormat, at, loc)
+			if due, err := gron.IsDue("30 2 * * *", ref); err != nil || due != expect {
+				t.Errorf("%s: expected %v, got %v, %v", at, expect, due, err)
+			}
+			if due, err := IsDue("30 2 * * *", ref); err != nil || due != expect {
+				t.Errorf("%s: expected stateless %v, got %v, %v", at, expect, due, err)
+			}
+		}
+	})
+
+	t.Run("spring forward once", func(t *testing.T) {
+		loc, err := time.LoadLocation("America/New_York")
+		abort(err)
+		ref := time.Date(2024, time.March, 10, 0, 0, 0, 0, loc)
+
+		times, err := gron.GetNextN("0,30 2-3 * * *", ref, 3)
+		expect := []string{"2024-03-10 03:00:00", "2024-03-10 03:30:00", "2024-03-11 02:00:00"}
+		if err != nil || len(times) != len(expect) {
+			t.Fatalf("expected %v, got %v, %v", expect, times, err)
+		}
+		for i, next := range times {
+			if next.Format(dateFormat) != expect[i] {
+				t.Errorf("expected %s, got %s", expect[i], next.Format(dateFormat))
+			}
+		}
+	})
+}
+
+func TestFallBack(t *testing.T) {
+	gron, format := New(), "2006-01-02 15:04:05 -0700"
+	at := func(zone, value string) time.Time {
+		loc, err := time.LoadLocation(zone)
+		abort(err)
+		ref, err := time.Parse(format, value)
+		abort(err)
+
+		return ref.In(loc)
+	}
+
+	t.Run("fall back next", func(t *testing.T) {
+		tests := []DSTCase{
+			{"America/New_York", "30 1 * * *", "2024-11-03 00:00:00 -0400", "2024-11-03 01:30:00 -0400"},
+			{"America/New_York", "30 1 * * *", "2024-11-03 01:31:00 -0400", "2024-11-04 01:30:00 -0500"},
+			{"America/New_York", "30 1 * * *", "2024-11-03 01:00:00 -0500", "2024-11-04 01:30:00 -0500"},
+			{"America/New_York", "*/30 * * * *", "2024-11-03 01:31:00 -0400", "2024-11-03 01:00:00 -0500"},
+			{"America/New_York", "0 2 * * *", "2024-11-03 00:00:00 -0400", "2024-11-03 02:00:00 -0500"},
+			{"Europe/Berlin", "30 2 * * *", "2024-10-27 02:31:00 +0200", "2024-10-28 02:30:00 +0100"},
+			{"Europe/Berlin", "*/15 2 * * *", "2025-10-26 02:50:00 +0200", "2025-10-27 02:00:00 +0100"},
+			{"America/Havana", "0 0 * * *", "2024-11-02 12:00:00 -0400", "2024-11-03 00:00:00 -0400"},
+		}
+
+		for _, test := range tests {
+			t.Run("fall back next "+test.Zone+" "+test.Expr+" "+test.Ref, func(t *testing.T) {
+				ref := at(test.Zone, test.Ref)
+
+				next, err := gron.GetNext(test.Expr, ref)
+				if err != nil || next.Format(format) != test.Expect {
+					t.Errorf("expected %s, got %v, %v", test.Expect, next, err)
+				}
+				if tick, err := NextTick(test.Expr, ref); err != nil || tick.Format(format) != test.Expect {
+					t.Errorf("expected stateless %s, got %v, %v", test.Expect, tick, err)
+				}
+			})
+		}
+	})
+
+	t.Run("fall back prev", func(t *testing.T) {
+		tests := []DSTCase{
+			{"America/New_York", "30 1 * * *", "2024-11-03 03:00:00 -0500", "2024-11-03 01:30:00 -0400"},
+			{"America/New_York", "30 1 * * *", "2024-11-03 01:30:00 -0500", "2024-11-03 01:30:00 -0400"},
+			{"America/New_York", "*/30 * * * *", "2024-11-03 01:59:00 -0500", "2024-11-03 01:30:00 -0500"},
+			{"Europe/Berlin", "30 2 * * *", "2024-10-27 12:00:00 +0100", "2024-10-27 02:30:00 +0200"},
+			{"America/Havana", "0 0 * * *", "2024-11-03 00:30:00 -0500", "2024-11-03 00:00:00 -0400"},
+		}
+
+		for _, test := range tests {
+			t.Run("fall back prev "+test.Zone+" "+test.Expr+" "+test.Ref, func(t *testing.T) {
+				ref := at(test.Zone, test.Ref)
+
+				prev, err := gron.GetPrev(test.Expr, ref)
+				if err != nil || prev.Format(format) != test.Expect {
+					t.Errorf("expected %s, got %v, %v", test.Expect, prev, err)
+				}
+				if tick, err := PrevTick(test.Expr, ref); err != nil || tick.Format(format) != test.Expect {
+					t.Errorf("expected stateless %s, got %v, %v", test.Expect, tick, err)
+				}
+			})
+		}
+	})
+
+	t.Run("fall back is due", func(t *testing.T) {
+		tests := map[string]bool{"2024-11-03 01:30:00 -0400": true, "2024-11-03 01:30:00 -0500": false, "2024-11-04 01:30:00 -0500": true}
+		for value, expect := range tests {
+			ref := at("America/New_York", value)
+			if due, err := gron.IsDue("30 1 * * *", ref); err != nil || due != expect {
+				t.Errorf("%s: expected %v, got %v, %v", value, expect, due, err)
+			}
+			if due, err := IsDue("30 1 * * *", ref); err != nil || due != expect {
+				t.Errorf("%s: expected stateless %v, got %v, %v", value, expect, due, err)
+			}
+			if due, err := gron.IsDue("*/30 * * * *", ref); err != nil || !due {
+				t.Errorf("%s: expected interval due, got %v, %v", value, due, err)
+			}
+		}
+	})
+
+	t.Run("fall back interval", func(t *testing.T) {
+		ref := at("America/New_York", "2024-11-03 00:45:00 -0400")
+
+		times, err := gron.GetNextN("*/30 * * * *", ref, 5)
+		expect := []string{"2024-11-03 01:00:00 -0400", "2024-11-03 01:30:00 -0400", "2024-11-03 01:00:00 -0500", "2024-11-03 01:30:00 -0500", "2024-11-03 02:00:00 -0500"}
+		if err != nil || len(times) != len(expect) {
+			t.Fatalf("expected %v, got %v, %v", expect, times, err)
+		}
+		for i, next := range times {
+			if next.Format(format) != expect[i] {
+				t.Errorf("expected %s, got %s", expect[i], next.Format(format))
+			}
+		}
+	})
+
+	t.Run("fall back once", func(t *testing.T) {
+		ref := at("America/New_York", "2024-11-03 00:00:00 -0400")
+
+		times, err := gron.GetNextN("0,30 1 * * *", ref, 3)
+		expect := []string{"2024-11-03 01:00:00 -0400", "2024-11-03 01:30:00 -0400", "2024-11-04 01:00:00 -0500"}
+		if err != nil || len(times) != len(expect) {
+			t.Fatalf("expected %v, got %v, %v", expect, times, err)
+		}
+		for i, next := range times {
+			if next.Format(format) != expect[i] {
+				t.Errorf("expected %s, got %s", expect[i], next.Format(format))
+			}
+		}
+	})
+}
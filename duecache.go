@@ -0,0 +1,108 @@
+package gronx
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+// DueCache memoizes the due of cron expressions for the minute (or second, as per expr) it was
+// last checked for, so that checking the same expr again within it doesn't evaluate it again,
+// eg: polling every few seconds. It keeps up to size exprs, evicting the least recently used
+// one when full. It is safe for concurrent use.
+type DueCache struct {
+	mu    sync.Mutex
+	gron  Gronx
+	size  int
+	items map[string]*list.Element
+	order *list.List
+}
+
+// dueEntry is the due of compiled expression of expr for the span starting at slot.
+type dueEntry struct {
+	expr string
+	e    *Expression
+	slot time.Time
+	due  bool
+}
+
+// NewDueCache gives DueCache of up to size cron expressions checked as per gron, which is at
+// least 1.
+func NewDueCache(gron Gronx, size int) *DueCache {
+	if size < 1 {
+		size = 1
+	}
+
+	return &DueCache{gron: gron, size: size, items: make(map[string]*list.Element, size), order: list.New()}
+}
+
+// IsDue checks if cron expr is due for t like Gronx.IsDue, with the result memoized until t is
+// in another minute (or second, as per expr), either later or earlier. The errors of invalid
+// expr are not memoized.
+func (d *DueCache) IsDue(expr string, t time.Time) (bool, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	item, ok := d.items[expr]
+	if ok {
+		d.order.MoveToFront(item)
+		entry := item.Value.(*dueEntry)
+		if slot := entry.e.slot(t); slot.Equal(entry.slot) {
+			return entry.due, nil
+		}
+	}
+
+	e, err := d.gron.Parse(expr)
+	if err != nil {
+		return false, err
+	}
+	due, err := e.isDue(t, d.gron.check(e))
+	if err != nil {
+		return false, err
+	}
+
+	entry := &dueEntry{expr: expr, e: e, slot: e.slot(t), due: due}
+	if ok {
+		item.Value = entry
+		return due, nil
+	}
+
+	d.items[expr] = d.order.PushFront(entry)
+	if d.order.Len() > d.size {
+		oldest := d.order.Back()
+		d.order.Remove(oldest)
+		delete(d.items, oldest.Value.(*dueEntry).expr)
+	}
+
+	return due, nil
+}
+
+// Len gives the count of memoized exprs.
+func (d *DueCache) Len() int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.order.Len()
+}
+
+// slot gives the start of span of t within which the expression is either due or not, ie the
+// minute or second as per its segments or interval, in its location.
+func (e *Expression) slot(t time.Time) time.Time {
+	ref := inZone(t, e.loc)
+	if e.every > 0 {
+		from, _ := everyUnit(ref, e.every)
+		return from
+	}
+
+	return truncTick(ref, tick(e.segs))
+}
+
+// span gives the length of span of slot, ie minute or second.
+func (e *Expression) span() time.Duration {
+	if e.every > 0 {
+		_, unit := everyUnit(time.Time{}, e.every)
+		return unit
+	}
+
+	return tick(e.segs)
+}
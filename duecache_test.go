@@ -0,0 +1,102 @@
+package gronx
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestDueCache(t *testing.T) {
+	ref := time.Date(2024, time.June, 3, 9, 0, 10, 0, time.UTC)
+
+	t.Run("due cache memoized", func(t *testing.T) {
+		cache := NewDueCache(New(), 4)
+		abort(RegisterMacro("@due-cache-test", "0 9 * * *", true))
+		if due, err := cache.IsDue("@due-cache-test", ref); err != nil || !due {
+			t.Errorf("expected due, got %v, %v", due, err)
+		}
+
+		// Not evaluated again within the minute, even if the macro now means otherwise.
+		abort(RegisterMacro("@due-cache-test", "1 9 * * *", true))
+		if due, err := cache.IsDue("@due-cache-test", ref.Add(40*time.Second)); err != nil || !due {
+			t.Errorf("expected memoized due, got %v, %v", due, err)
+		}
+		if due, err := cache.IsDue("@due-cache-test", ref.Add(50*time.Second)); err != nil || !due {
+			t.Errorf("expected due in next minute, got %v, %v", due, err)
+		}
+		if due, err := cache.IsDue("@due-cache-test", ref.Add(-5*time.Second)); err != nil || due {
+			t.Errorf("expected not due going back in time, got %v, %v", due, err)
+		}
+	})
+
+	t.Run("due cache seconds", func(t *testing.T) {
+		cache := NewDueCache(New(WithSecondsField()), 4)
+		for sec, expect := range map[int]bool{10: true, 11: false, 20: true} {
+			at := ref.Add(time.Duration(sec-10) * time.Second)
+			if due, err := cache.IsDue("*/10 * * * * *", at); err != nil || due != expect {
+				t.Errorf("second %d: expected %v, got %v, %v", sec, expect, due, err)
+			}
+		}
+		if due, err := cache.IsDue("@every 15s", ref.Add(5*time.Second)); err != nil || !due {
+			t.Errorf("expected sub-minute interval due, got %v, %v", due, err)
+		}
+		if due, err := cache.IsDue("@every 15s", ref.Add(6*time.Second)); err != nil || due {
+			t.Errorf("expected sub-minute interval not due, got %v, %v", due, err)
+		}
+	})
+
+	t.Run("due cache zone", func(t *testing.T) {
+		cache, fresh := NewDueCache(New(), 4), New()
+		expr := "CRON_TZ=Asia/Kathmandu 45 14 * * *"
+		for _, at := range []time.Time{ref, ref.Add(15 * time.Second), ref.Add(time.Minute), ref.Add(-time.Minute)} {
+			expect, _ := fresh.IsDue(expr, at)
+			if due, err := cache.IsDue(expr, at); err != nil || due != expect {
+				t.Errorf("%v: expected %v, got %v, %v", at, expect, due, err)
+			}
+		}
+	})
+
+	t.Run("due cache bounded", func(t *testing.T) {
+		cache := NewDueCache(New(), 2)
+		for _, expr := range []string{"1 * * * *", "2 * * * *", "3 * * * *"} {
+			_, _ = cache.IsDue(expr, ref)
+		}
+		if n := cache.Len(); n != 2 {
+			t.Errorf("expected 2 memoized, got %d", n)
+		}
+		if _, ok := cache.items["1 * * * *"]; ok {
+			t.Errorf("expected least recently used evicted")
+		}
+		if n := NewDueCache(New(), 0).size; n != 1 {
+			t.Errorf("expected size 1, got %d", n)
+		}
+	})
+
+	t.Run("due cache invalid", func(t *testing.T) {
+		cache := NewDueCache(New(), 2)
+		if _, err := cache.IsDue("61 * * * *", ref); err == nil {
+			t.Errorf("expected error, got nil")
+		}
+		if n := cache.Len(); n != 0 {
+			t.Errorf("expected error not memoized, got %d", n)
+		}
+	})
+
+	t.Run("due cache concurrent", func(t *testing.T) {
+		cache, wg := NewDueCache(New(), 4), sync.WaitGroup{}
+		for i := 0; i < 8; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				for j := 0; j < 50; j++ {
+					expr, at := fmt.Sprintf("%d 9 * * *", (i+j)%6), ref.Add(time.Duration(j%3)*time.Minute)
+					if due, err := cache.IsDue(expr, at); err != nil || due != ((i+j)%6 == j%3) {
+						t.Errorf("%s at %v: expected due %v, got %v, %v", expr, at, (i+j)%6 == j%3, due, err)
+					}
+				}
+			}(i)
+		}
+		wg.Wait()
+	})
+}
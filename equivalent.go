@@ -0,0 +1,210 @@
+package gronx
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+// equivalenceYears is the number of years from the first year either expr is due in, within
+// which CheckEquivalence compares the days they are due on. It covers a leap year cycle.
+const equivalenceYears = 4
+
+// Equivalence tells how sure CheckEquivalence is that two cron exprs are due at the same times.
+type Equivalence int
+
+const (
+	// NotEquivalent is when there is a time due for one of the exprs but not the other.
+	NotEquivalent Equivalence = iota
+	// ProbablyEquivalent is when the exprs are due on the same days within the years compared,
+	// or their hours differ in being restricted only, which is due differently around DST.
+	ProbablyEquivalent
+	// DefinitelyEquivalent is when the exprs are due for the same values of each segment.
+	DefinitelyEquivalent
+)
+
+func (e Equivalence) String() string {
+	switch e {
+	case NotEquivalent:
+		return "not equivalent"
+	case ProbablyEquivalent:
+		return "probably equivalent"
+	case DefinitelyEquivalent:
+		return "definitely equivalent"
+	}
+
+	return "unknown"
+}
+
+// Equivalent checks if cron exprs a and b are due at the same times like CheckEquivalence, where
+// they are only if they are definitely so. It returns error if either of them is invalid.
+func Equivalent(a, b string) (bool, error) {
+	eq, err := CheckEquivalence(a, b)
+
+	return eq == DefinitelyEquivalent, err
+}
+
+// CheckEquivalence checks if cron exprs a and b are due at the same times like IsDue. They are
+// definitely equivalent if each segment is due for the same values (eg: SAT,SUN and 6,0, */10
+// and 0,10,20,30,40,50), or else the days they are due on are compared for the 4 years from the
+// first year either is due in and the years only one of them has. So with L, W or # they are
+// probably equivalent if they are due on the same days then, or else definitely not. The exprs
+// in different zones (CRON_TZ=) are not equivalent. It returns error if either of them is invalid.
+func CheckEquivalence(a, b string) (Equivalence, error) {
+	segsA, zoneA, err := equivalenceSegments(a)
+	if err != nil {
+		return NotEquivalent, err
+	}
+	segsB, zoneB, err := equivalenceSegments(b)
+	if err != nil {
+		return NotEquivalent, err
+	}
+
+	switch {
+	case zoneA != zoneB, (segsA == nil) != (segsB == nil):
+		return NotEquivalent, nil
+	case segsA == nil, strings.Join(segsA, " ") == strings.Join(segsB, " "):
+		return DefinitelyEquivalent, nil
+	}
+
+	same, static := true, true
+	for pos := range segsA {
+		valuesA, okA := staticValues(segsA[pos], pos)
+		valuesB, okB := staticValues(segsB[pos], pos)
+		static = static && okA && okB
+		same = same && sameInts(valuesA, valuesB)
+	}
+	if static && same {
+		if wallFixed(segsA) == wallFixed(segsB) {
+			return DefinitelyEquivalent, nil
+		}
+		return ProbablyEquivalent, nil
+	}
+
+	return equivalentDays(segsA, segsB), nil
+}
+
+// equivalenceSegments gives the segments of cron expr with year, or nil for @reboot, along
+// with the name of zone of its CRON_TZ= prefix if any.
+func equivalenceSegments(expr string) ([]string, string, error) {
+	expr, loc, err := exprZone(expr)
+	if err != nil {
+		return nil, "", err
+	}
+	zone := ""
+	if loc != nil {
+		zone = loc.String()
+	}
+
+	if err := Validate(expr); err != nil {
+		return nil, "", err
+	}
+	segs, err := Segments(expr)
+	if errors.Is(err, ErrReboot) {
+		return nil, zone, nil
+	}
+	if err != nil {
+		return nil, "", err
+	}
+	if len(segs) == PosYear {
+		segs = append(segs, "*")
+	}
+
+	return segs, zone, nil
+}
+
+// equivalentDays compares the days segsA and segsB are due on for the years from the first
+// year either of them is due in, and the years only one of them has. They are not equivalent
+// if there is a day only one of them is due on, or a day they both are due on at other times.
+func equivalentDays(segsA, segsB []string) Equivalence {
+	yearsA, _ := staticValues(segsA[PosYear], PosYear)
+	yearsB, _ := staticValues(segsB[PosYear], PosYear)
+	first := yearsA[0]
+	if yearsB[0] < first {
+		first = yearsB[0]
+	}
+
+	days := false
+	for day := time.Date(first, time.January, 1, 0, 0, 0, 0, time.UTC); day.Year() < first+equivalenceYears; day = day.AddDate(0, 0, 1) {
+		dueA, dueB := dayDue(segsA, day), dayDue(segsB, day)
+		if dueA != dueB {
+			return NotEquivalent
+		}
+		days = days || dueA
+	}
+	if yearDue(segsA, yearsA, yearsB) || yearDue(segsB, yearsB, yearsA) {
+		return NotEquivalent
+	}
+
+	for _, pos := range []int{PosMinute, PosHour} {
+		valuesA, _ := staticValues(segsA[pos], pos)
+		valuesB, _ := staticValues(segsB[pos], pos)
+		if days && !sameInts(valuesA, valuesB) {
+			return NotEquivalent
+		}
+	}
+
+	return ProbablyEquivalent
+}
+
+// dayDue checks if day is due for the segments of date of segs.
+func dayDue(segs []string, day time.Time) bool {
+	for _, pos := range []int{PosYear, PosMonth, PosDayOfMonth, PosDayOfWeek} {
+		if segs[pos] == "*" || segs[pos] == "?" {
+			continue
+		}
+		if due, err := checkDue(segs[pos], pos, day); !due || err != nil {
+			return false
+		}
+	}
+
+	return true
+}
+
+// yearDue checks if segs are due on any day of the years that are not in others.
+func yearDue(segs []string, years, others []int) bool {
+	other := map[int]bool{}
+	for _, year := range others {
+		other[year] = true
+	}
+
+	for _, year := range years {
+		if other[year] {
+			continue
+		}
+		for day := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC); day.Year() == year; day = day.AddDate(0, 0, 1) {
+			if dayDue(segs, day) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// staticValues gives the values of segment at given position like ExpandSegment, where * and ?
+// are all the values. It returns false if segment has L, W or #.
+func staticValues(segment string, pos int) ([]int, bool) {
+	if strings.ContainsAny(segment, "LW#") {
+		return nil, false
+	}
+	if segment == "*" || segment == "?" {
+		segment = "*/1"
+	}
+
+	return segmentValues(segment, pos), true
+}
+
+// sameInts checks if a and b have the same ints in the same order.
+func sameInts(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
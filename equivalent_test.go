@@ -0,0 +1,107 @@
+package gronx
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+func TestCheckEquivalence(t *testing.T) {
+	tests := []struct {
+		a, b   string
+		expect Equivalence
+	}{
+		{"0 0 * * SAT,SUN", "0 0 * * 6,0", DefinitelyEquivalent},
+		{"*/10 * * * *", "0,10,20,30,40,50 * * * *", DefinitelyEquivalent},
+		{"@daily", "0  0 * * *", DefinitelyEquivalent},
+		{"0 0 * * 7", "0 0 * * 0", DefinitelyEquivalent},
+		{"0 0 ? * 1-5", "0 0 1-31 JAN-DEC MON-FRI", DefinitelyEquivalent},
+		{"0 0 1 1 *", "0 0 1 1 * *", DefinitelyEquivalent},
+		{"0 0 L * *", "0 0 l * *", DefinitelyEquivalent},
+		{"@reboot", "@REBOOT", DefinitelyEquivalent},
+		{"CRON_TZ=UTC 0 0 * * *", "TZ=UTC @midnight", DefinitelyEquivalent},
+		{"0 0 * * 5#5", "0 0 29-31 * 5", ProbablyEquivalent},
+		{"0 0 L 2 *", "0 0 L 2 * 1970-2099", ProbablyEquivalent},
+		{"0 0 30 2 *", "0 0 31 2 *", ProbablyEquivalent},
+		{"0 0 1-31 2 *", "0 0 1-29 2 *", ProbablyEquivalent},
+		{"0 0-23 * * *", "0 * * * *", ProbablyEquivalent},
+		{"0 0 L 2 *", "0 0 28,29 2 *", NotEquivalent},
+		{"0 0 L 2 *", "0 0 29 2 *", NotEquivalent},
+		{"0 0 1W * *", "0 0 1-3 * 1-5", NotEquivalent},
+		{"0 0 L * *", "30 0 L * *", NotEquivalent},
+		{"0 0 1 * *", "30 0 1 * *", NotEquivalent},
+		{"0 0 1 1 * 2030", "0 0 1 1 * 2030,2050", NotEquivalent},
+		{"0 0 * * *", "CRON_TZ=UTC 0 0 * * *", NotEquivalent},
+		{"@reboot", "* * * * *", NotEquivalent},
+	}
+
+	for _, test := range tests {
+		t.Run("equivalence "+test.a+" and "+test.b, func(t *testing.T) {
+			for _, pair := range [][2]string{{test.a, test.b}, {test.b, test.a}} {
+				actual, err := CheckEquivalence(pair[0], pair[1])
+				if err != nil {
+					t.Fatalf("expected no error, got %v", err)
+				}
+				if actual != test.expect {
+					t.Errorf("expected %v, got %v", test.expect, actual)
+				}
+			}
+		})
+	}
+
+	t.Run("equivalence invalid", func(t *testing.T) {
+		for _, pair := range [][2]string{{"61 * * * *", "* * * * *"}, {"* * * * *", "@every 1h"}, {"H * * * *", "* * * * *"}} {
+			if _, err := CheckEquivalence(pair[0], pair[1]); err == nil {
+				t.Errorf("expected error for %s and %s, got nil", pair[0], pair[1])
+			}
+		}
+	})
+}
+
+func TestEquivalent(t *testing.T) {
+	tests := map[[2]string]bool{
+		{"0 0 * * SAT,SUN", "0 0 * * 6,0"}:   true,
+		{"0 0 * * 5#5", "0 0 29-31 * 5"}:     false,
+		{"0 0-23 * * *", "0 * * * *"}:        false,
+		{"*/10 * * * *", "0-50/10 * * * *"}:  true,
+		{"0 0 L 2 *", "0 0 29 2 *"}:          false,
+		{"*/15 * * * *", "*/20 * * * *"}:     false,
+		{"0 9 * * mon-fri", "0 9 * * 1,2-5"}: true,
+	}
+
+	for pair, expect := range tests {
+		t.Run("equivalent "+pair[0]+" and "+pair[1], func(t *testing.T) {
+			actual, err := Equivalent(pair[0], pair[1])
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if actual != expect {
+				t.Errorf("expected %v, got %v", expect, actual)
+			}
+		})
+	}
+}
+
+func TestCheckEquivalenceCanonical(t *testing.T) {
+	rnd := rand.New(rand.NewSource(96))
+	limits := [][2]int{{0, 59}, {0, 23}, {1, 31}, {1, 12}, {0, 7}}
+
+	for i := 0; i < 200; i++ {
+		segs := make([]string, len(limits))
+		for pos, limit := range limits {
+			segs[pos] = randomOffset(rnd, limit[0], limit[1])
+		}
+
+		expr := strings.Join(segs, " ")
+		canon, err := Canonicalize(expr)
+		if err != nil {
+			continue
+		}
+
+		t.Run("equivalence canonical "+expr, func(t *testing.T) {
+			if actual, err := CheckEquivalence(expr, canon); err != nil || actual == NotEquivalent {
+				t.Errorf("expected %s equivalent to %s, got %v (%v)", canon, expr, actual, err)
+			}
+		})
+	}
+}
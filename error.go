@@ -0,0 +1,185 @@
+package gronx
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ErrNoOccurrence is the error when no due time exists within the searched window.
+var ErrNoOccurrence = errors.New("no due time found for cron expression")
+
+// ErrOverlapUndecided is the error when Overlaps can't tell if cron exprs are ever due at the
+// same time, as it found none within the years searched but can't rule out the ones after.
+var ErrOverlapUndecided = errors.New("could not decide if cron expressions overlap")
+
+// The errors to tell the kind of invalid cron expression with errors.Is, which the errors
+// telling what exactly is invalid wrap.
+var (
+	ErrSegmentCount = errors.New("invalid number of segments in cron expression")
+	ErrOutOfRange   = errors.New("value out of range in cron segment")
+	ErrUnknownToken = errors.New("unknown token in cron segment")
+	ErrInvalidStep  = errors.New("invalid step in cron segment")
+	ErrDialect      = errors.New("construct not allowed in cron dialect")
+	ErrLimit        = errors.New("cron expression exceeds limit")
+	// ErrDynamicToken is the error for L, W or # whose values depend on the month, as told by
+	// ExpandSegment.
+	ErrDynamicToken = errors.New("dynamic token in cron segment has no static values")
+)
+
+// The limits of cron expression, beyond which it is invalid with LimitError so that untrusted
+// input can't take too much time or memory.
+const (
+	// MaxExprLength is the maximum length of cron expression in bytes.
+	MaxExprLength = 1024
+	// MaxListElements is the maximum number of elements in list of a segment.
+	MaxListElements = 256
+)
+
+// LimitError is the error when cron expression exceeds the limit like MaxExprLength, with the
+// Max allowed and the Actual size. It wraps ErrLimit.
+type LimitError struct {
+	Limit  string // eg: length
+	Max    int
+	Actual int
+}
+
+func (e *LimitError) Error() string {
+	return fmt.Sprintf("cron expression %s %d exceeds limit of %d", e.Limit, e.Actual, e.Max)
+}
+
+// Unwrap gives the underlying ErrLimit.
+func (e *LimitError) Unwrap() error {
+	return ErrLimit
+}
+
+// limitErr gives LimitError if expr exceeds MaxExprLength or any segment has more than
+// MaxListElements elements.
+func limitErr(expr string) error {
+	if len(expr) > MaxExprLength {
+		return &LimitError{Limit: "length", Max: MaxExprLength, Actual: len(expr)}
+	}
+
+	for _, seg := range strings.Fields(expr) {
+		if n := strings.Count(seg, ",") + 1; n > MaxListElements {
+			return &LimitError{Limit: "list elements", Max: MaxListElements, Actual: n}
+		}
+	}
+
+	return nil
+}
+
+// ErrReboot is the error when cron expression is @reboot, which is due only once at startup
+// and has no time to be checked or searched for. The expr is otherwise valid.
+var ErrReboot = errors.New("@reboot cron expression has no due time, it runs only at startup")
+
+// SearchError is the error when next or previous due time can't be found within the
+// search years. It wraps ErrNoOccurrence.
+type SearchError struct {
+	Expr string
+	Ref  time.Time
+	Year int // the boundary year reached
+	Prev bool
+}
+
+func (e *SearchError) Error() string {
+	dir := "next"
+	if e.Prev {
+		dir = "previous"
+	}
+
+	return fmt.Sprintf("could not find %s due for cron expression %q from %s until year %d", dir, e.Expr, e.Ref.Format(time.RFC3339), e.Year)
+}
+
+// Unwrap gives the underlying ErrNoOccurrence.
+func (e *SearchError) Unwrap() error {
+	return ErrNoOccurrence
+}
+
+// The reasons of FieldError, as codes for machines.
+const (
+	ReasonSyntax        = "syntax"
+	ReasonOutOfRange    = "out-of-range"
+	ReasonStep          = "step"
+	ReasonUnknownName   = "unknown-name"
+	ReasonMisplacedName = "misplaced-name"
+	ReasonModifier      = "modifier"
+	ReasonQuestionMark  = "question-mark"
+	ReasonHash          = "hash"
+	ReasonRandom        = "random"
+	ReasonDialect       = "dialect"
+)
+
+// FieldError is the error for invalid segment of cron expression at Pos (eg: PosMinute), with
+// the segment as given and the Reason code (eg: ReasonOutOfRange). It wraps the error telling
+// what is invalid, whose message it has. The Suggestion is the known name closest to unknown
+// name if any, eg: MON for MOM.
+type FieldError struct {
+	Pos        int
+	Segment    string
+	Reason     string
+	Err        error
+	Suggestion string
+	// Given is the segment as written in expr (eg: FRI-MOM for 5-MOM) if known, which the
+	// message quotes instead of Segment.
+	Given string
+}
+
+func (e *FieldError) Error() string {
+	if e.Given == "" || e.Given == e.Segment {
+		return e.Err.Error()
+	}
+
+	return givenText(e.Err.Error(), e.Segment, e.Given)
+}
+
+// Unwrap gives the underlying error.
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// reasonErrs are the errors that FieldError is by its reason.
+var reasonErrs = map[string]error{
+	ReasonSyntax:        ErrUnknownToken,
+	ReasonUnknownName:   ErrUnknownToken,
+	ReasonMisplacedName: ErrUnknownToken,
+	ReasonOutOfRange:    ErrOutOfRange,
+	ReasonStep:          ErrInvalidStep,
+	ReasonDialect:       ErrDialect,
+}
+
+// Is checks if target is the error for the reason, like ErrOutOfRange for ReasonOutOfRange.
+func (e *FieldError) Is(target error) bool {
+	err, ok := reasonErrs[e.Reason]
+
+	return ok && err == target
+}
+
+// kindError is the error with its own message that wraps one of the errors of kind.
+type kindError struct {
+	msg  string
+	kind error
+}
+
+func (e *kindError) Error() string {
+	return e.msg
+}
+
+func (e *kindError) Unwrap() error {
+	return e.kind
+}
+
+// kindErr gives error with msg that wraps kind.
+func kindErr(kind error, msg string) error {
+	return &kindError{msg: msg, kind: kind}
+}
+
+// fieldErr wraps err if any as FieldError of segment at given position with reason.
+func fieldErr(pos int, segment, reason string, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return &FieldError{Pos: pos, Segment: segment, Reason: reason, Err: err}
+}
@@ -0,0 +1,144 @@
+package gronx
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestFieldError(t *testing.T) {
+	tests := []struct {
+		expr    string
+		pos     int
+		segment string
+		reason  string
+	}{
+		{"61 * * * *", PosMinute, "61", ReasonOutOfRange},
+		{"0 1-2-3 * * *", PosHour, "1-2-3", ReasonSyntax},
+		{"0 0 1,15,L5 * *", PosDayOfMonth, "1,15,L5", ReasonModifier},
+		{"0 0 * 13 *", PosMonth, "13", ReasonOutOfRange},
+		{"0 0 * JANUARYY *", PosMonth, "JANUARYY", ReasonUnknownName},
+		{"0 0 * * JAN", PosDayOfWeek, "JAN", ReasonMisplacedName},
+		{"0 0 * * 1#6", PosDayOfWeek, "1#6", ReasonModifier},
+		{"*/75 * * * *", PosMinute, "*/75", ReasonStep},
+		{"0 0 1 1 * 2100", PosYear, "2100", ReasonOutOfRange},
+		{"0 0 1 1 * 2030-2025", PosYear, "2030-2025", ReasonSyntax},
+	}
+
+	for _, test := range tests {
+		t.Run("field error "+test.expr, func(t *testing.T) {
+			var ferr *FieldError
+			if err := Validate(test.expr); !errors.As(err, &ferr) {
+				t.Fatalf("expected FieldError, got %v", err)
+			}
+			if ferr.Pos != test.pos || ferr.Segment != test.segment || ferr.Reason != test.reason {
+				t.Errorf("expected %d %s %s, got %d %s %s", test.pos, test.segment, test.reason, ferr.Pos, ferr.Segment, ferr.Reason)
+			}
+		})
+	}
+
+	t.Run("field error wrapped", func(t *testing.T) {
+		err := fmt.Errorf("job: %w", Validate("0 25 * * *"))
+
+		var ferr *FieldError
+		if !errors.As(err, &ferr) || ferr.Pos != PosHour {
+			t.Errorf("expected FieldError at hour, got %v", err)
+		}
+		if expect := "value 25 in hour segment should be 0-23: 25"; ferr.Error() != expect {
+			t.Errorf("expected %s, got %s", expect, ferr.Error())
+		}
+		if errors.Unwrap(ferr) == nil {
+			t.Errorf("expected underlying error, got nil")
+		}
+	})
+
+	t.Run("field error gronx", func(t *testing.T) {
+		var ferr *FieldError
+		gron := New()
+		if _, err := gron.IsDue("H * * * *"); !errors.As(err, &ferr) || ferr.Reason != ReasonHash || ferr.Pos != PosMinute {
+			t.Errorf("expected hash FieldError at minute, got %v", err)
+		}
+
+		secs, strict, iso := New(WithSecondsField()), New(WithStrict()), New(WithISOWeekday())
+		if err := secs.Validate("75 * * * * *"); !errors.As(err, &ferr) || ferr.Pos != PosSecond {
+			t.Errorf("expected FieldError at second, got %v", err)
+		}
+		if err := strict.Validate("0 ? * * *"); !errors.As(err, &ferr) || ferr.Reason != ReasonQuestionMark || ferr.Pos != PosHour {
+			t.Errorf("expected question mark FieldError at hour, got %v", err)
+		}
+		if err := iso.Validate("0 0 * * 0"); !errors.As(err, &ferr) || ferr.Reason != ReasonOutOfRange {
+			t.Errorf("expected out of range FieldError, got %v", err)
+		}
+	})
+
+	t.Run("field error check due", func(t *testing.T) {
+		var ferr *FieldError
+		checker, ref := SegmentChecker{}, time.Date(2021, time.April, 19, 10, 30, 0, 0, time.UTC)
+		if _, err := checker.CheckDue("1,X", PosMinute, ref); !errors.As(err, &ferr) || ferr.Segment != "1,X" {
+			t.Errorf("expected FieldError for 1,X, got %v", err)
+		}
+		if _, err := checker.CheckDue("5X", PosDayOfWeek, ref); !errors.As(err, &ferr) || ferr.Pos != PosDayOfWeek {
+			t.Errorf("expected FieldError at week day, got %v", err)
+		}
+	})
+}
+
+func TestSentinelErrors(t *testing.T) {
+	gron := New()
+	tests := []struct {
+		expr   string
+		expect error
+	}{
+		{"* * *", ErrSegmentCount},
+		{"* * * * * * *", ErrSegmentCount},
+		{"61 * * * *", ErrOutOfRange},
+		{"0 0 * 13 *", ErrOutOfRange},
+		{"0 0 1 1 * 2100", ErrOutOfRange},
+		{"0 0 * * FRU", ErrUnknownToken},
+		{"0 0 * SUN *", ErrUnknownToken},
+		{"A * * * *", ErrUnknownToken},
+		{"*/0 * * * *", ErrInvalidStep},
+		{"*/75 * * * *", ErrInvalidStep},
+		{"@every 1x", ErrUnknownToken},
+		{"@every 0s", ErrOutOfRange},
+	}
+
+	for _, test := range tests {
+		t.Run("sentinel "+test.expr, func(t *testing.T) {
+			if err := gron.Validate(test.expr); !errors.Is(err, test.expect) {
+				t.Errorf("expected %v, got %v", test.expect, err)
+			}
+		})
+	}
+
+	t.Run("sentinel is due", func(t *testing.T) {
+		if _, err := gron.IsDue("0 0 * * FRU"); !errors.Is(err, ErrUnknownToken) {
+			t.Errorf("expected ErrUnknownToken, got %v", err)
+		}
+		if _, err := gron.IsDue("* * *"); !errors.Is(err, ErrSegmentCount) {
+			t.Errorf("expected ErrSegmentCount, got %v", err)
+		}
+	})
+
+	t.Run("sentinel many", func(t *testing.T) {
+		err := Validate("75 0 * * FRU")
+		if !errors.Is(err, ErrOutOfRange) || !errors.Is(err, ErrUnknownToken) || errors.Is(err, ErrInvalidStep) {
+			t.Errorf("expected out of range and unknown token, got %v", err)
+		}
+	})
+
+	t.Run("sentinel message", func(t *testing.T) {
+		err := Validate("* * *")
+		if expect := "expr should contain 5-6 segments separated by space"; err.Error() != expect {
+			t.Errorf("expected %s, got %s", expect, err.Error())
+		}
+	})
+
+	t.Run("sentinel no occurrence", func(t *testing.T) {
+		_, err := gron.GetPrev("0 0 30 2 *")
+		if !errors.Is(err, ErrNoOccurrence) || errors.Is(err, ErrOutOfRange) {
+			t.Errorf("expected ErrNoOccurrence, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,91 @@
+package gronx
+
+import (
+	"strings"
+	"time"
+)
+
+// parseEvery parses the interval of @every expr like @every 90s or @every 2h30m.
+// It returns the interval, whether expr is @every and error if the interval is invalid.
+func parseEvery(expr string) (time.Duration, bool, error) {
+	expr = strings.Trim(expr, " \t")
+	if len(expr) < 6 || !strings.EqualFold(expr[:6], "@every") {
+		return 0, false, nil
+	}
+
+	if err := limitErr(expr); err != nil {
+		return 0, true, err
+	}
+
+	every, err := time.ParseDuration(strings.Trim(expr[6:], " \t"))
+	if err != nil {
+		return 0, true, kindErr(ErrUnknownToken, "invalid @every duration: "+expr)
+	}
+	if every <= 0 || every%time.Second != 0 {
+		return 0, true, kindErr(ErrOutOfRange, "@every duration should be positive whole seconds: "+expr)
+	}
+
+	return every, true, nil
+}
+
+// everyUnit gives the edge of span of ref that counts for interval, ie seconds for sub-minute
+// intervals and minutes otherwise, along with the span.
+func everyUnit(ref time.Time, every time.Duration) (time.Time, time.Duration) {
+	if every%time.Minute == 0 {
+		return truncMinute(ref), time.Minute
+	}
+
+	return ref.Add(-time.Duration(ref.Nanosecond())), time.Second
+}
+
+// everyAnchor gives the time from which @every intervals are counted.
+func (g *Gronx) everyAnchor() time.Time {
+	if g.anchor.IsZero() {
+		return time.Unix(0, 0)
+	}
+
+	return g.anchor
+}
+
+// everyNext gets the first interval on or after the span of ref (or after, if exclusive).
+func (g *Gronx) everyNext(every time.Duration, ref time.Time) time.Time {
+	from, unit := everyUnit(ref, every)
+	if g.exclusive {
+		from = from.Add(unit)
+	}
+
+	anchor := g.everyAnchor()
+	since := from.Sub(anchor)
+	n := since / every
+	if since%every > 0 {
+		n++
+	}
+
+	return anchor.Add(n * every).In(ref.Location())
+}
+
+// everyPrev gets the last interval on or before the span of ref (or before, if exclusive).
+func (g *Gronx) everyPrev(every time.Duration, ref time.Time) time.Time {
+	until, unit := everyUnit(ref, every)
+	if !g.exclusive {
+		until = until.Add(unit)
+	}
+
+	anchor := g.everyAnchor()
+	since := until.Sub(anchor) - time.Nanosecond
+	n := since / every
+	if since%every < 0 {
+		n--
+	}
+
+	return anchor.Add(n * every).In(ref.Location())
+}
+
+// everyDue checks if an interval falls in the span of ref.
+func (g *Gronx) everyDue(every time.Duration, ref time.Time) bool {
+	from, unit := everyUnit(ref, every)
+	gron := *g
+	gron.exclusive = false
+
+	return gron.everyNext(every, ref).Before(from.Add(unit))
+}
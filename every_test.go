@@ -0,0 +1,197 @@
+package gronx
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestEvery(t *testing.T) {
+	gron := New()
+	tests := []struct {
+		expr, ref, next, prev string
+		due                   bool
+	}{
+		{"@every 1h", "2021-04-19 10:00:00", "2021-04-19 10:00:00", "2021-04-19 10:00:00", true},
+		{"@every 1h", "2021-04-19 10:00:45", "2021-04-19 10:00:00", "2021-04-19 10:00:00", true},
+		{"@every 1h", "2021-04-19 10:01:00", "2021-04-19 11:00:00", "2021-04-19 10:00:00", false},
+		{"@every 2h30m", "2021-04-19 10:01:00", "2021-04-19 11:00:00", "2021-04-19 08:30:00", false},
+		{"@every 90s", "2021-04-19 10:00:00", "2021-04-19 10:00:00", "2021-04-19 10:00:00", true},
+		{"@every 90s", "2021-04-19 10:00:01", "2021-04-19 10:01:30", "2021-04-19 10:00:00", false},
+		{"@every 30s", "2021-04-19 10:00:45", "2021-04-19 10:01:00", "2021-04-19 10:00:30", false},
+		{"@every 30s", "2021-04-19 10:00:30", "2021-04-19 10:00:30", "2021-04-19 10:00:30", true},
+		{"  @EVERY 1m  ", "2021-04-19 10:00:30", "2021-04-19 10:00:00", "2021-04-19 10:00:00", true},
+		{"@every 1h", "1969-12-31 22:30:00", "1969-12-31 23:00:00", "1969-12-31 22:00:00", false},
+	}
+
+	for _, test := range tests {
+		ref, _ := time.Parse(dateFormat, test.ref)
+		ref = ref.Add(123 * time.Millisecond)
+		t.Run("every "+test.expr+" "+test.ref, func(t *testing.T) {
+			if due, err := gron.IsDue(test.expr, ref); err != nil || due != test.due {
+				t.Errorf("expected %v, got %v, %v", test.due, due, err)
+			}
+			if next, err := gron.GetNext(test.expr, ref); err != nil || next.Format(dateFormat) != test.next {
+				t.Errorf("expected %v, got %v, %v", test.next, next, err)
+			}
+			if prev, err := gron.GetPrev(test.expr, ref); err != nil || prev.Format(dateFormat) != test.prev {
+				t.Errorf("expected %v, got %v, %v", test.prev, prev, err)
+			}
+			if !gron.IsValid(test.expr) {
+				t.Errorf("expected valid, got invalid")
+			}
+		})
+	}
+
+	t.Run("every exclusive", func(t *testing.T) {
+		gron := New(WithExclusive())
+		ref, _ := time.Parse(dateFormat, "2021-04-19 10:00:30")
+		if next, err := gron.GetNext("@every 30s", ref); err != nil || next.Format(dateFormat) != "2021-04-19 10:01:00" {
+			t.Errorf("expected 2021-04-19 10:01:00, got %v, %v", next, err)
+		}
+		if prev, err := gron.GetPrev("@every 30s", ref); err != nil || prev.Format(dateFormat) != "2021-04-19 10:00:00" {
+			t.Errorf("expected 2021-04-19 10:00:00, got %v, %v", prev, err)
+		}
+	})
+
+	t.Run("every anchor", func(t *testing.T) {
+		anchor, _ := time.Parse(dateFormat, "2021-04-19 10:07:00")
+		gron := New(WithAnchor(anchor))
+		ref, _ := time.Parse(dateFormat, "2021-04-19 12:00:00")
+		if next, err := gron.GetNext("@every 1h", ref); err != nil || next.Format(dateFormat) != "2021-04-19 12:07:00" {
+			t.Errorf("expected 2021-04-19 12:07:00, got %v, %v", next, err)
+		}
+		if due, err := gron.IsDue("@every 1h", ref.Add(7*time.Minute)); err != nil || !due {
+			t.Errorf("expected true, got %v, %v", due, err)
+		}
+	})
+
+	t.Run("every keeps location", func(t *testing.T) {
+		ref := time.Date(2021, time.April, 19, 10, 20, 0, 0, time.FixedZone("NPT", 20700))
+		if next, err := gron.GetNext("@every 1h", ref); err != nil || next.Location() != ref.Location() || next.Minute() != 45 {
+			t.Errorf("expected 10:45 in NPT, got %v, %v", next, err)
+		}
+	})
+
+	for _, expr := range []string{"@every", "@every 0s", "@every -1m", "@every 1x", "@every 1500ms", "@everyday"} {
+		t.Run("every err "+expr, func(t *testing.T) {
+			if gron.IsValid(expr) {
+				t.Errorf("expected false, got true")
+			}
+			if _, err := gron.GetNext(expr); err == nil {
+				t.Errorf("expected error, got nil")
+			}
+			if _, err := gron.GetPrev(expr); err == nil {
+				t.Errorf("expected error, got nil")
+			}
+		})
+	}
+
+	t.Run("every segments", func(t *testing.T) {
+		if _, err := Segments("@every 1m"); err == nil {
+			t.Errorf("expected error, got nil")
+		}
+		if times, err := gron.GetNextN("@every 1m", time.Now(), 2); err != nil || len(times) != 2 {
+			t.Errorf("expected 2 due times, got %v, %v", times, err)
+		}
+	})
+}
+
+func TestEveryEntryPoints(t *testing.T) {
+	gron, expr := New(), "@every 90s"
+	ref := time.Date(2021, 4, 19, 10, 0, 0, 0, time.UTC)
+	at := func(d time.Duration) time.Time { return ref.Add(d) }
+	same := func(name string, actual []time.Time, expect ...time.Time) {
+		if len(actual) != len(expect) {
+			t.Errorf("%s: expected %v, got %v", name, expect, actual)
+			return
+		}
+		for i := range expect {
+			if !actual[i].Equal(expect[i]) {
+				t.Errorf("%s: expected %v, got %v", name, expect, actual)
+				return
+			}
+		}
+	}
+
+	if due, err := gron.IsDue(expr, ref); err != nil || !due {
+		t.Errorf("IsDue: expected true, got %v, %v", due, err)
+	}
+	if next, err := gron.GetNext(expr, at(time.Second)); err != nil || !next.Equal(at(90*time.Second)) {
+		t.Errorf("GetNext: expected %v, got %v, %v", at(90*time.Second), next, err)
+	}
+	if prev, err := gron.GetPrev(expr, at(time.Second)); err != nil || !prev.Equal(ref) {
+		t.Errorf("GetPrev: expected %v, got %v, %v", ref, prev, err)
+	}
+
+	times, err := gron.GetNextN(expr, ref, 3)
+	if err != nil {
+		t.Errorf("GetNextN: expected no error, got %v", err)
+	}
+	same("GetNextN", times, ref, at(90*time.Second), at(3*time.Minute))
+
+	times, err = gron.GetPrevN(expr, at(3*time.Minute), 2)
+	if err != nil {
+		t.Errorf("GetPrevN: expected no error, got %v", err)
+	}
+	same("GetPrevN", times, at(3*time.Minute), at(90*time.Second))
+
+	if _, err := gron.GetNextBefore(expr, at(time.Second), at(time.Minute)); !errors.Is(err, ErrNoOccurrence) {
+		t.Errorf("GetNextBefore: expected ErrNoOccurrence, got %v", err)
+	}
+	if next, err := gron.GetNextBefore(expr, at(time.Second), at(90*time.Second)); err != nil || !next.Equal(at(90*time.Second)) {
+		t.Errorf("GetNextBefore: expected %v, got %v, %v", at(90*time.Second), next, err)
+	}
+
+	if late, err := gron.IsOverdue(expr, ref, 10*time.Second, at(105*time.Second)); err != nil || !late {
+		t.Errorf("IsOverdue: expected true, got %v, %v", late, err)
+	}
+	if late, err := gron.IsOverdue(expr, ref, 10*time.Second, at(95*time.Second)); err != nil || late {
+		t.Errorf("IsOverdue: expected false, got %v, %v", late, err)
+	}
+
+	times, err = gron.OccurrencesBetween(expr, ref, at(3*time.Minute))
+	if err != nil {
+		t.Errorf("OccurrencesBetween: expected no error, got %v", err)
+	}
+	same("OccurrencesBetween", times, ref, at(90*time.Second), at(3*time.Minute))
+
+	times, err = gron.MissedRuns(expr, ref, at(3*time.Minute))
+	if err != nil {
+		t.Errorf("MissedRuns: expected no error, got %v", err)
+	}
+	same("MissedRuns", times, at(90*time.Second), at(3*time.Minute))
+
+	if due, err := gron.IsDueBetween(expr, at(time.Second), at(89*time.Second)); err != nil || due {
+		t.Errorf("IsDueBetween: expected false, got %v, %v", due, err)
+	}
+	if due, err := gron.IsDueBetween(expr, at(time.Second), at(90*time.Second)); err != nil || !due {
+		t.Errorf("IsDueBetween: expected true, got %v, %v", due, err)
+	}
+
+	if count, err := gron.CountOccurrences(expr, ref, at(3*time.Minute)); err != nil || count != 3 {
+		t.Errorf("CountOccurrences: expected 3, got %d, %v", count, err)
+	}
+
+	times = []time.Time{}
+	err = gron.WalkOccurrences(context.Background(), expr, ref, at(3*time.Minute), func(next time.Time) error {
+		times = append(times, next)
+		return nil
+	})
+	if err != nil {
+		t.Errorf("WalkOccurrences: expected no error, got %v", err)
+	}
+	same("WalkOccurrences", times, ref, at(90*time.Second), at(3*time.Minute))
+
+	if nearest, dist, err := gron.NearestRun(expr, at(50*time.Second)); err != nil || !nearest.Equal(at(90*time.Second)) || dist != 40*time.Second {
+		t.Errorf("NearestRun: expected %v by 40s, got %v by %v, %v", at(90*time.Second), nearest, dist, err)
+	}
+
+	exclusive := New(WithExclusive())
+	times, err = exclusive.GetNextN(expr, ref, 2)
+	if err != nil {
+		t.Errorf("GetNextN: expected no error, got %v", err)
+	}
+	same("GetNextN exclusive", times, at(90*time.Second), at(3*time.Minute))
+}
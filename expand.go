@@ -0,0 +1,60 @@
+package gronx
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ExpandSegment gives the values that a single segment at given position (eg: PosMonth) is due
+// for, sorted and without duplicates, with the same names, trimming and case as in expr and the
+// values within bounds of the position (eg: 0-6 for week day where 7 is sunday, the default
+// years for year). It returns error if segment is invalid like IsValidSegment, or FieldError
+// wrapping ErrDynamicToken if it has L, W or # as they have no values without the month.
+func ExpandSegment(seg string, pos int) ([]int, error) {
+	if err := IsValidSegment(seg, pos); err != nil {
+		return nil, err
+	}
+
+	seg = strings.ToUpper(strings.Trim(seg, " \t"))
+	switch pos {
+	case PosMonth:
+		seg = replaceNames(seg, months)
+	case PosDayOfWeek:
+		seg = replaceNames(seg, days)
+	}
+	if strings.ContainsAny(seg, "LW#") {
+		msg := fmt.Sprintf("values of %s segment depend on the month: %s", segmentNames[pos], seg)
+		return nil, fieldErr(pos, seg, ReasonModifier, kindErr(ErrDynamicToken, msg))
+	}
+	if seg == "*" || seg == "?" {
+		seg = "*/1"
+	}
+
+	return segmentValues(seg, pos), nil
+}
+
+// segmentValues gives the values within bounds of segment at given position that it is due for,
+// sorted and without duplicates, the same as checking it for due time does.
+func segmentValues(segment string, pos int) []int {
+	min, max := valueBounds(pos)
+	if pos == PosDayOfWeek {
+		// The 7 is sunday, same as 0.
+		max = 6
+	}
+
+	// The segment valid for fastSegment is so for any value, which checks it without allocating.
+	_, fast := fastSegment(segment, pos, min)
+
+	values := []int{}
+	for val := min; val <= max; val++ {
+		due, _ := fastSegment(segment, pos, val)
+		if !fast {
+			due = offsetsDue(segment, pos, val)
+		}
+		if due {
+			values = append(values, val)
+		}
+	}
+
+	return values
+}
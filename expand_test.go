@@ -0,0 +1,124 @@
+package gronx
+
+import (
+	"errors"
+	"math/rand"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestExpandSegment(t *testing.T) {
+	tests := []struct {
+		seg    string
+		pos    int
+		expect []int
+	}{
+		{"5,1,5,3", PosMinute, []int{1, 3, 5}},
+		{"*/15", PosMinute, []int{0, 15, 30, 45}},
+		{"10-20/5", PosSecond, []int{10, 15, 20}},
+		{"22-2", PosHour, []int{0, 1, 2, 22, 23}},
+		{"*/10", PosDayOfMonth, []int{1, 11, 21, 31}},
+		{"?", PosDayOfMonth, seq(1, 31)},
+		{" jan-mar,feb ", PosMonth, []int{1, 2, 3}},
+		{"*", PosMonth, seq(1, 12)},
+		{"mon-fri", PosDayOfWeek, []int{1, 2, 3, 4, 5}},
+		{"5-7", PosDayOfWeek, []int{0, 5, 6}},
+		{"sat-sun", PosDayOfWeek, []int{0, 6}},
+		{"2025,2024", PosYear, []int{2024, 2025}},
+		{"2095/2", PosYear, []int{2095, 2097, 2099}},
+	}
+
+	for _, test := range tests {
+		t.Run("expand "+test.seg, func(t *testing.T) {
+			actual, err := ExpandSegment(test.seg, test.pos)
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if !reflect.DeepEqual(actual, test.expect) {
+				t.Errorf("expected %v, got %v", test.expect, actual)
+			}
+		})
+	}
+
+	t.Run("dynamic tokens", func(t *testing.T) {
+		for seg, pos := range map[string]int{"L": PosDayOfMonth, "15W": PosDayOfMonth, "1,LW": PosDayOfMonth, "5L": PosDayOfWeek, "fri#2": PosDayOfWeek} {
+			_, err := ExpandSegment(seg, pos)
+			var ferr *FieldError
+			if !errors.Is(err, ErrDynamicToken) || !errors.As(err, &ferr) || ferr.Reason != ReasonModifier {
+				t.Errorf("expected ErrDynamicToken for %s, got %v", seg, err)
+			}
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for seg, pos := range map[string]int{"60": PosMinute, "13": PosMonth, "MOM": PosDayOfWeek, "H": PosMinute, "32W": PosDayOfMonth, "*": 7} {
+			if _, err := ExpandSegment(seg, pos); err == nil || errors.Is(err, ErrDynamicToken) {
+				t.Errorf("expected invalid segment error for %s, got %v", seg, err)
+			}
+		}
+	})
+}
+
+// TestExpandSegmentDue checks that the values of random segments are due and only them are.
+func TestExpandSegmentDue(t *testing.T) {
+	rng := rand.New(rand.NewSource(91))
+	check := SegmentChecker{}
+	for i := 0; i < 2000; i++ {
+		pos := rng.Intn(PosSecond + 1)
+		min, max := valueBounds(pos)
+		num := func() string { return strconv.Itoa(min + rng.Intn(max-min+1)) }
+		seg := num()
+		switch rng.Intn(4) {
+		case 1:
+			seg += "-" + num()
+		case 2:
+			seg = "*/" + strconv.Itoa(1+rng.Intn(12))
+		case 3:
+			seg += "-" + num() + "/" + strconv.Itoa(1+rng.Intn(12)) + "," + num()
+		}
+
+		values, err := ExpandSegment(seg, pos)
+		if err != nil {
+			// The wrapping ranges are invalid for some positions.
+			continue
+		}
+		t.Run("due "+seg+" at "+segmentNames[pos], func(t *testing.T) {
+			min, max := valueBounds(pos)
+			for val := min; val <= max; val++ {
+				expect := false
+				for _, v := range values {
+					expect = expect || v == val || (pos == PosDayOfWeek && v == 0 && val == 7)
+				}
+				actual, err := check.CheckDue(seg, pos, refFor(pos, val))
+				if err != nil {
+					t.Fatalf("expected no error, got %v", err)
+				}
+				if actual != expect {
+					t.Errorf("expected %v for %d, got %v", expect, val, actual)
+				}
+			}
+		})
+	}
+}
+
+// refFor gives time with given value at position, where week day 0-7 is on the week of 2024-01-07.
+func refFor(pos, val int) time.Time {
+	switch pos {
+	case PosMinute:
+		return time.Date(2024, 1, 1, 0, val, 0, 0, time.UTC)
+	case PosHour:
+		return time.Date(2024, 1, 1, val, 0, 0, 0, time.UTC)
+	case PosDayOfMonth:
+		return time.Date(2024, 1, val, 0, 0, 0, 0, time.UTC)
+	case PosMonth:
+		return time.Date(2024, time.Month(val), 1, 0, 0, 0, 0, time.UTC)
+	case PosDayOfWeek:
+		return time.Date(2024, 1, 7+val%7, 0, 0, 0, 0, time.UTC)
+	case PosYear:
+		return time.Date(val, 1, 1, 0, 0, 0, 0, time.UTC)
+	}
+
+	return time.Date(2024, 1, 1, 0, 0, val, 0, time.UTC)
+}
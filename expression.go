@@ -0,0 +1,327 @@
+package gronx
+
+import (
+	"math/bits"
+	"strings"
+	"time"
+)
+
+// Expression is cron expression compiled once as per the options of Gronx, with the due values
+// of plain segments (values, ranges, steps and lists) precomputed, so that checking it many
+// times doesn't parse it again. The special ones like L, W and # are checked as usual. It is
+// immutable and safe for concurrent use. The custom Checker of Gronx is not used.
+type Expression struct {
+	expr  string
+	loc   *time.Location
+	every time.Duration
+	segs  []string
+	given []string
+	// The due values of plain segment at the position, with bit n set for value n.
+	values [PosSecond + 1]uint64
+	plain  [PosSecond + 1]bool
+	table  *fireTable
+	gron   Gronx
+}
+
+// Parse compiles cron expr into Expression with factory defaults. It returns error if expr is
+// invalid, including @reboot.
+func Parse(expr string) (*Expression, error) {
+	gron := New()
+
+	return gron.Parse(expr)
+}
+
+// Parse compiles cron expr into Expression as per the options of Gronx, with the CRON_TZ= or
+// TZ= prefix if any. It returns error if expr is invalid, including @reboot.
+func (g *Gronx) Parse(expr string) (*Expression, error) {
+	if g.cache == nil {
+		return g.compile(expr)
+	}
+
+	if entry, ok := g.cache.get(expr); ok {
+		return entry.e, entry.err
+	}
+	e, err := g.compile(expr)
+	g.cache.put(cacheEntry{expr, e, err})
+
+	return e, err
+}
+
+// compile compiles cron expr into Expression like Parse, without cache.
+func (g *Gronx) compile(expr string) (*Expression, error) {
+	expr, loc, err := g.zone(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	e := &Expression{expr: expr, loc: loc, gron: *g}
+	e.gron.C = nil
+	if every, ok, err := g.every(expr); ok {
+		if err != nil {
+			return nil, err
+		}
+		e.every = every
+		return e, nil
+	}
+
+	if e.segs, err = g.segments(expr); err != nil {
+		return nil, err
+	}
+	e.given = givenSegments(expr, g.seconds)
+	for pos, seg := range e.segs {
+		e.values[pos], e.plain[pos] = plainValues(seg, pos)
+	}
+	e.table = newFireTable(e)
+
+	return e, nil
+}
+
+// plainValues gives the due values of segment at given position with bit n set for value n, and
+// whether it is plain. Year is never plain, as its values don't fit, nor is the segment with an
+// invalid element so that it errors when checked as usual.
+func plainValues(segment string, pos int) (uint64, bool) {
+	if pos == PosYear || !canonicalRe.MatchString(segment) {
+		return 0, false
+	}
+
+	var values uint64
+	offsets, min, max := strings.Split(segment, ","), bounds[pos][0], bounds[pos][1]
+	for val := min; val <= max; val++ {
+		for _, offset := range offsets {
+			due, err := isOffsetDue(offset, val, min, max)
+			if !due && err == nil && pos == PosDayOfWeek && val == 0 {
+				// Sunday is 7 too.
+				due, err = isOffsetDue(offset, 7, min, max)
+			}
+			if err != nil {
+				return 0, false
+			}
+			if due {
+				values |= 1 << uint(val)
+				break
+			}
+		}
+	}
+
+	return values, true
+}
+
+// check checks if segment at given position is due for ref using the precomputed values if it
+// is plain, or as usual otherwise.
+func (e *Expression) check(segment string, pos int, ref time.Time) (bool, error) {
+	if pos < len(e.segs) && e.plain[pos] && segment == e.segs[pos] {
+		return e.values[pos]&(1<<uint(valueByPos(ref, pos))) != 0, nil
+	}
+
+	return checkDue(segment, pos, ref)
+}
+
+// IsDue checks if the expression is due for t like Gronx.IsDue.
+func (e *Expression) IsDue(t time.Time) bool {
+	due, _ := e.isDue(t, nil)
+
+	return due
+}
+
+// Next gets the next due time on or after t like Gronx.GetNext, and whether it is found.
+func (e *Expression) Next(t time.Time) (time.Time, bool) {
+	next, err := e.next(t, nil)
+
+	return next, err == nil
+}
+
+// Prev gets the previous due time on or before t like Gronx.GetPrev, and whether it is found.
+func (e *Expression) Prev(t time.Time) (time.Time, bool) {
+	prev, err := e.prev(t, nil)
+
+	return prev, err == nil
+}
+
+// isDue checks if the expression is due for ref, with check for the due of each segment, or
+// its own with the fire table if any when nil.
+func (e *Expression) isDue(ref time.Time, check dueFunc) (bool, error) {
+	ref = inZone(ref, e.loc)
+	if e.every > 0 {
+		return e.gron.everyDue(e.every, ref), nil
+	}
+	if check == nil {
+		if due, ok := e.table.due(ref); ok {
+			return due, nil
+		}
+		check = e.check
+	}
+
+	due := dayOrFor(e.segs, e.gron.dayOr, check)
+	pos, err := undueSegment(e.segs, ref, due)
+	if err != nil {
+		return false, e.gron.givenErr(e.expr, err)
+	}
+
+	ok, err := dstDue(e.segs, ref, pos < 0, due)
+
+	return ok, e.gron.givenErr(e.expr, err)
+}
+
+// next finds the next due time on or after ref, with check for the due of each segment, or
+// its own with the fire table if any when nil. It returns SearchError if there is none within
+// the search horizon.
+func (e *Expression) next(ref time.Time, check dueFunc) (time.Time, error) {
+	start := inZone(ref, e.loc)
+	if e.every > 0 {
+		return e.gron.everyNext(e.every, start), nil
+	}
+
+	limit := e.gron.horizon(start, false)
+	next, ok, err := e.nextUntil(e.nextRef(start), limit, check)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if !ok {
+		return time.Time{}, &SearchError{Expr: e.expr, Ref: start, Year: limit.Year()}
+	}
+
+	return next, nil
+}
+
+// prev finds the previous due time on or before ref, with check for the due of each segment,
+// or its own with the fire table if any and jumps for the plain ones when nil. It returns
+// SearchError if there is none within the search horizon.
+func (e *Expression) prev(ref time.Time, check dueFunc) (time.Time, error) {
+	start := inZone(ref, e.loc)
+	if e.every > 0 {
+		return e.gron.everyPrev(e.every, start), nil
+	}
+
+	limit := e.gron.horizon(start, true)
+	prev, ok, err := e.prevUntil(e.prevRef(start), limit, check)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if !ok {
+		return time.Time{}, &SearchError{Expr: e.expr, Ref: start, Year: limit.Year(), Prev: true}
+	}
+
+	return prev, nil
+}
+
+// nextUntil finds the due time on or after ref and on or before limit like next, regardless of
+// exclusivity, along with whether it is found.
+func (e *Expression) nextUntil(ref, limit time.Time, check dueFunc) (time.Time, bool, error) {
+	ref, limit = inZone(ref, e.loc), inZone(limit, e.loc)
+	if e.every > 0 {
+		gron := e.gron
+		gron.exclusive = false
+		next := gron.everyNext(e.every, ref)
+		return next, !next.After(limit), nil
+	}
+
+	if check == nil {
+		if next, ok := e.table.next(ref); ok {
+			return next, !next.After(limit), nil
+		}
+		check = e.check
+	}
+	next, ok, err := nextTime(e.segs, ref, limit, dayOrFor(e.segs, e.gron.dayOr, check))
+	if err != nil {
+		return time.Time{}, false, e.gron.givenErr(e.expr, err)
+	}
+
+	return next, ok, nil
+}
+
+// prevUntil finds the due time on or before ref and on or after limit like prev, regardless of
+// exclusivity, along with whether it is found.
+func (e *Expression) prevUntil(ref, limit time.Time, check dueFunc) (time.Time, bool, error) {
+	ref, limit = inZone(ref, e.loc), inZone(limit, e.loc)
+	if e.every > 0 {
+		gron := e.gron
+		gron.exclusive = false
+		prev := gron.everyPrev(e.every, ref)
+		return prev, !prev.Before(limit), nil
+	}
+
+	jump := jumpFunc(nil)
+	if check == nil {
+		if prev, ok := e.table.prev(ref); ok {
+			return prev, !prev.Before(limit), nil
+		}
+		check, jump = e.check, e.jump
+	}
+	prev, ok, err := prevTime(e.segs, ref, limit, dayOrFor(e.segs, e.gron.dayOr, check), jump)
+	if err != nil {
+		return time.Time{}, false, e.gron.givenErr(e.expr, err)
+	}
+
+	return prev, ok, nil
+}
+
+// nextRef gives the reference time to look for next due time from, wrt exclusivity.
+func (e *Expression) nextRef(ref time.Time) time.Time {
+	if e.gron.exclusive {
+		return e.slot(ref).Add(e.span())
+	}
+
+	return ref
+}
+
+// prevRef gives the reference time to look for previous due time from, wrt exclusivity.
+func (e *Expression) prevRef(ref time.Time) time.Time {
+	if e.gron.exclusive {
+		return e.slot(ref).Add(-e.span())
+	}
+
+	return ref
+}
+
+// jump moves ref to the last minute (or second, as per step) of the latest due value of the
+// plain segment at given position before its value in ref, or of the previous period of the
+// next significant segment if there is none, with the less significant ones at their maxima.
+// Day of week is not jumped, nor are days when either of them is due (WithDayOr).
+func (e *Expression) jump(ref time.Time, pos int, step time.Duration) (time.Time, bool) {
+	if pos >= len(e.segs) || !e.plain[pos] || pos == PosDayOfWeek {
+		return ref, false
+	}
+	if pos == PosDayOfMonth && e.gron.dayOr && dayRestricted(e.segs) {
+		return ref, false
+	}
+
+	below := e.values[pos] & (1<<uint(valueByPos(ref, pos)) - 1)
+	if below == 0 {
+		jumped, err := bumpPrev(ref, nextSignificant[pos], step)
+		return jumped, err == nil && jumped.Before(ref)
+	}
+
+	year, month, day := ref.Date()
+	_, minute, second := ref.Clock()
+	loc, val := ref.Location(), bits.Len64(below)
+
+	// The end of latest due value is the start of the one after it. The minutes and seconds
+	// go by the elapsed time like bumpPrev, as the interval ones are due in DST overlap twice.
+	var end time.Time
+	switch pos {
+	case PosMonth:
+		end = dayStart(year, time.Month(val), 1, loc)
+	case PosDayOfMonth:
+		end = dayStart(year, month, val, loc)
+	case PosHour:
+		end, _ = firstInstant(wallTime(year, month, day, val, loc))
+	case PosMinute:
+		end = truncMinute(ref).Add(-time.Duration(minute-val) * time.Minute)
+	case PosSecond:
+		end = ref.Add(-time.Duration(second-val) * time.Second)
+	}
+	jumped := end.Add(-step)
+
+	return jumped, jumped.Before(ref)
+}
+
+// check gives the func to check if segment of e is due, which is the Checker of Gronx if it
+// is custom, so that it keeps working with the string based methods. It is nil for the factory
+// one, so that e uses its own along with the precomputed tables.
+func (g *Gronx) check(e *Expression) dueFunc {
+	if _, ok := g.C.(*SegmentChecker); ok {
+		return nil
+	}
+
+	return g.due
+}
@@ -0,0 +1,153 @@
+package gronx
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	t.Run("parse is due", func(t *testing.T) {
+		gron := New()
+		for _, test := range testcases() {
+			if test.Ref == "" {
+				continue
+			}
+			ref, err := time.Parse(dateFormat, test.Ref)
+			abort(err)
+
+			expect, gerr := gron.IsDue(test.Expr, ref)
+			e, err := Parse(test.Expr)
+			if err != nil {
+				if gerr == nil {
+					t.Errorf("%s: expected no error, got %v", test.Expr, err)
+				}
+				continue
+			}
+			if due := e.IsDue(ref); due != expect || due != test.Expect {
+				t.Errorf("%s: expected %v at %v, got %v", test.Expr, test.Expect, ref, due)
+			}
+		}
+	})
+
+	t.Run("parse next prev", func(t *testing.T) {
+		for _, test := range nextcases() {
+			e, err := Parse(test.Expr)
+			if err != nil {
+				t.Fatalf("%s: expected no error, got %v", test.Expr, err)
+			}
+			if next, ok := e.Next(test.ref()); !ok || next.Format(dateFormat) != test.Expect {
+				t.Errorf("%s: expected next %s, got %v, %v", test.Expr, test.Expect, next, ok)
+			}
+		}
+		for _, test := range prevcases() {
+			e, err := Parse(test.Expr)
+			if err != nil {
+				t.Fatalf("%s: expected no error, got %v", test.Expr, err)
+			}
+			if prev, ok := e.Prev(test.ref()); !ok || prev.Format(dateFormat) != test.Expect {
+				t.Errorf("%s: expected prev %s, got %v, %v", test.Expr, test.Expect, prev, ok)
+			}
+		}
+	})
+
+	t.Run("parse special", func(t *testing.T) {
+		tests := []NextCase{
+			{"0 0 L * *", "2024-02-10 00:00:00", "2024-02-29 00:00:00"},
+			{"0 0 * * 5#2", "2024-02-01 00:00:00", "2024-02-09 00:00:00"},
+			{"0 0 15W * *", "2024-06-01 00:00:00", "2024-06-14 00:00:00"},
+			{"0 0 1 1 * 2030", "2024-06-01 00:00:00", "2030-01-01 00:00:00"},
+			{"@every 90m", "2024-06-01 00:10:00", "2024-06-01 01:30:00"},
+			{"CRON_TZ=UTC 30 9 * * 1-5", "2024-06-01 00:00:00", "2024-06-03 09:30:00"},
+		}
+		for _, test := range tests {
+			e, err := Parse(test.Expr)
+			if err != nil {
+				t.Fatalf("%s: expected no error, got %v", test.Expr, err)
+			}
+			next, ok := e.Next(test.ref())
+			if !ok || next.Format(dateFormat) != test.Expect || !e.IsDue(next) {
+				t.Errorf("%s: expected due next %s, got %v, %v", test.Expr, test.Expect, next, ok)
+			}
+		}
+	})
+
+	t.Run("parse options", func(t *testing.T) {
+		gron := New(WithDayOr(), WithSecondsField())
+		e, err := gron.Parse("30 0 0 13 * FRI")
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if ref := time.Date(2024, time.June, 7, 0, 0, 30, 0, time.UTC); !e.IsDue(ref) {
+			t.Errorf("expected due on friday at %v, got not due", ref)
+		}
+		if next, ok := e.Next(time.Date(2024, time.June, 8, 0, 0, 0, 0, time.UTC)); !ok || next.Day() != 13 {
+			t.Errorf("expected next on 13th, got %v, %v", next, ok)
+		}
+	})
+
+	t.Run("parse unsatisfiable", func(t *testing.T) {
+		e, err := Parse("0 0 30 2 *")
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if next, ok := e.Next(time.Now()); ok || !next.IsZero() {
+			t.Errorf("expected none, got %v", next)
+		}
+		if prev, ok := e.Prev(time.Now()); ok || !prev.IsZero() {
+			t.Errorf("expected none, got %v", prev)
+		}
+	})
+
+	t.Run("parse invalid", func(t *testing.T) {
+		for _, expr := range []string{"* * * *", "61 * * * *", "@every 0s", "CRON_TZ=Mars/Olympus * * * * *"} {
+			if e, err := Parse(expr); err == nil || e != nil {
+				t.Errorf("%s: expected error, got %v", expr, e)
+			}
+		}
+		if _, err := Parse("@reboot"); !errors.Is(err, ErrReboot) {
+			t.Errorf("expected ErrReboot, got %v", err)
+		}
+	})
+
+	t.Run("parse concurrent", func(t *testing.T) {
+		e, err := Parse("*/5 9-17 * * MON-FRI")
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		ref, wg := time.Date(2024, time.June, 3, 9, 0, 0, 0, time.UTC), sync.WaitGroup{}
+		for i := 0; i < 8; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				at := ref.Add(time.Duration(i) * time.Minute)
+				if e.IsDue(at) != (i%5 == 0) {
+					t.Errorf("%v: expected due %v", at, i%5 == 0)
+				}
+				if next, ok := e.Next(at); !ok || next.Minute()%5 != 0 {
+					t.Errorf("%v: expected next at 5th minute, got %v", at, next)
+				}
+			}(i)
+		}
+		wg.Wait()
+	})
+}
+
+func BenchmarkExpressionIsDue(b *testing.B) {
+	ref, gron := time.Date(2024, time.June, 3, 9, 5, 0, 0, time.UTC), New()
+	e, err := gron.Parse("*/5 9-17 * * MON-FRI")
+	abort(err)
+
+	b.Run("string", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, _ = gron.IsDue("*/5 9-17 * * MON-FRI", ref)
+		}
+	})
+	b.Run("compiled", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			e.IsDue(ref)
+		}
+	})
+}
@@ -0,0 +1,159 @@
+package gronx
+
+import "time"
+
+// fastDue checks if expr is due for ref without allocating, if expr is already normalized, ie
+// 5 segments of values, ranges and steps separated by single space that are valid as such. It
+// returns whether it is due and whether it could tell, where the others are left to Parse.
+func (g *Gronx) fastDue(expr string, ref time.Time) (bool, bool) {
+	if _, ok := g.C.(*SegmentChecker); !ok || g.seconds || g.quartz || g.iso || g.strict || g.dialect != DialectDefault {
+		return false, false
+	}
+
+	expr, loc, err := g.zone(expr)
+	if err != nil || len(expr) > MaxExprLength {
+		return false, false
+	}
+
+	var segs [PosDayOfWeek + 1]string
+	for pos, start := 0, 0; pos < len(segs); pos++ {
+		end := start
+		for end < len(expr) && expr[end] != ' ' {
+			end++
+		}
+		if end == start || (pos < PosDayOfWeek) == (end == len(expr)) {
+			return false, false
+		}
+		segs[pos], start = expr[start:end], end+1
+	}
+
+	ref = inZone(ref, loc)
+	_, month, day := ref.Date()
+	hour, minute, _ := ref.Clock()
+	values := [...]int{minute, hour, day, int(month), int(ref.Weekday())}
+
+	var dues [PosDayOfWeek + 1]bool
+	for pos, seg := range segs {
+		due, ok := fastSegment(seg, pos, values[pos])
+		if !ok {
+			return false, false
+		}
+		dues[pos] = due
+	}
+
+	dom, dow := segs[PosDayOfMonth], segs[PosDayOfWeek]
+	if g.dayOr && dom[0] != '*' && dow[0] != '*' {
+		dues[PosDayOfMonth] = dues[PosDayOfMonth] || dues[PosDayOfWeek]
+		dues[PosDayOfWeek] = true
+	}
+	due := dues[PosMinute] && dues[PosHour] && dues[PosDayOfMonth] && dues[PosMonth] && dues[PosDayOfWeek]
+
+	// The DST rules apply to the hour restricted, where the gap is left to Parse.
+	if segs[PosHour] == "*" {
+		return due, true
+	}
+	if ref = truncMinute(ref); due {
+		_, repeated := firstInstant(ref)
+		return !repeated, true
+	}
+	_, off := ref.Zone()
+	_, prevOff := ref.Add(-time.Minute).Zone()
+
+	return false, off <= prevOff
+}
+
+// fastSegment checks if segment of values, ranges and steps at given position is due for val
+// without allocating. It returns whether it is due and whether the segment is valid as such.
+func fastSegment(segment string, pos, val int) (bool, bool) {
+	if segment == "*" {
+		return true, true
+	}
+
+	due, elems := false, 0
+	for start := 0; start <= len(segment); elems++ {
+		end := start
+		for end < len(segment) && segment[end] != ',' {
+			end++
+		}
+
+		ok, valid := fastOffset(segment[start:end], pos, val)
+		if !valid || elems >= MaxListElements {
+			return false, false
+		}
+		due, start = due || ok, end+1
+	}
+
+	return due, true
+}
+
+// fastOffset checks if offset like 5, 1-5, */5 or 1-30/5 at given position is due for val
+// without allocating, as isOffsetDue does. It returns whether it is due and whether the offset
+// is valid as such, where wrapping ranges and value with step are not.
+func fastOffset(offset string, pos, val int) (bool, bool) {
+	min, max := bounds[pos][0], bounds[pos][1]
+	hi := max
+	if pos == PosDayOfWeek {
+		// Both 0 and 7 are sunday.
+		hi = 7
+	}
+
+	i, star, start, end, step := 0, false, 0, -1, -1
+	if star = len(offset) > 0 && offset[0] == '*'; star {
+		i = 1
+	} else if start, i = fastNumber(offset, i); i == 0 {
+		return false, false
+	}
+	if !star && i < len(offset) && offset[i] == '-' {
+		n := i + 1
+		if end, i = fastNumber(offset, n); i == n {
+			return false, false
+		}
+	}
+	if i < len(offset) && offset[i] == '/' {
+		n := i + 1
+		if step, i = fastNumber(offset, n); i == n {
+			return false, false
+		}
+	}
+
+	switch {
+	case i != len(offset), star && step < 0, !star && step >= 0 && end < 0:
+		return false, false
+	case !star && (start < min || start > hi || (end >= 0 && (end < start || end > hi))):
+		return false, false
+	case step == 0 || step > max-min+1:
+		return false, false
+	}
+
+	due := func(val int) bool {
+		switch {
+		case star:
+			return (val-min)%step == 0
+		case end < 0:
+			return val == start
+		case step >= 0:
+			return start <= val && val <= end && (val-start)%step == 0
+		}
+		return start <= val && val <= end
+	}
+	if pos == PosDayOfWeek && val == 0 {
+		// Sunday is 7 too.
+		return due(0) || due(7), true
+	}
+
+	return due(val), true
+}
+
+// fastNumber parses the number of up to 4 digits in s from i without allocating. It returns the
+// number and the index after it, which is i if there is none.
+func fastNumber(s string, i int) (int, int) {
+	num, j := 0, i
+	for j < len(s) && j-i < 4 && s[j] >= '0' && s[j] <= '9' {
+		num, j = num*10+int(s[j]-'0'), j+1
+	}
+	if j < len(s) && s[j] >= '0' && s[j] <= '9' {
+		return 0, i
+	}
+
+	return num, j
+}
@@ -0,0 +1,133 @@
+package gronx
+
+import (
+	"math/rand"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFastDue(t *testing.T) {
+	ref := time.Date(2024, time.June, 3, 9, 5, 0, 0, time.UTC)
+
+	t.Run("fast due", func(t *testing.T) {
+		tests := map[string]bool{
+			"5 9 * * *":        true,
+			"5 9 * * 1":        true,
+			"*/5 * * * *":      true,
+			"0-10/5 9 3 6 1-5": true,
+			"5 9 * * 0-7":      true,
+			"6 9 * * *":        false,
+			"5 9 * * 7":        false,
+		}
+		gron := New()
+		for expr, expect := range tests {
+			due, ok := gron.fastDue(expr, ref)
+			if !ok || due != expect {
+				t.Errorf("%s: expected fast %v, got %v, %v", expr, expect, due, ok)
+			}
+		}
+	})
+
+	t.Run("fast due fallback", func(t *testing.T) {
+		gron := New()
+		for _, expr := range []string{"5  9 * * *", " 5 9 * * *", "5 9 * * MON", "5 9 L * *", "61 9 * * *", "*/0 * * * *", "5/10 * * * *", "50-10 * * * *", "* * * * * 2024", "*,5 * * * *", "@daily", "5-/2 * * * *"} {
+			if _, ok := gron.fastDue(expr, ref); ok {
+				t.Errorf("%s: expected fallback, got fast", expr)
+			}
+		}
+		for _, gron := range []Gronx{New(WithSecondsField()), New(WithISOWeekday()), New(WithStrict()), New(WithDialect(DialectPOSIX))} {
+			if _, ok := gron.fastDue("5 9 * * *", ref); ok {
+				t.Errorf("expected fallback with options, got fast")
+			}
+		}
+	})
+
+	t.Run("fast due same", func(t *testing.T) {
+		rng := rand.New(rand.NewSource(81))
+		var min, max int
+		num := func() string { return strconv.Itoa(min + rng.Intn(max-min+2)) }
+		forms := []func() string{
+			func() string { return num() },
+			func() string { return num() + "-" + num() },
+			func() string { return "*/" + num() },
+			func() string { return num() + "-" + num() + "/" + num() },
+			func() string { return num() + "/" + num() },
+		}
+		gron, dayOr, fastN := New(), New(WithDayOr()), 0
+		for i := 0; i < 20000; i++ {
+			segs := make([]string, 5)
+			for pos := range segs {
+				min, max = valueBounds(pos)
+				if rng.Intn(4) == 0 {
+					segs[pos] = "*"
+					continue
+				}
+				offsets := make([]string, 1+rng.Intn(2))
+				for j := range offsets {
+					offsets[j] = forms[rng.Intn(len(forms))]()
+				}
+				segs[pos] = strings.Join(offsets, ",")
+			}
+			expr := strings.Join(segs, " ")
+			at := ref.Add(time.Duration(rng.Intn(60*24*400)) * time.Minute)
+
+			for _, gron := range []Gronx{gron, dayOr} {
+				due, fast := gron.fastDue(expr, at)
+				if !fast {
+					continue
+				}
+				fastN++
+				e, err := gron.Parse(expr)
+				if err != nil {
+					t.Fatalf("%s: expected fallback for invalid, got fast: %v", expr, err)
+				}
+				if expect, err := e.isDue(at, e.check); err != nil || due != expect {
+					t.Errorf("%s at %v: expected %v, %v, got fast %v", expr, at, expect, err, due)
+				}
+			}
+		}
+		if fastN < 1000 {
+			t.Errorf("expected at least 1000 fast checks, got %d", fastN)
+		}
+	})
+
+	t.Run("fast due dst", func(t *testing.T) {
+		loc, err := time.LoadLocation("America/New_York")
+		abort(err)
+
+		gron := New()
+		for _, test := range []struct {
+			expr, at string
+			expect   bool
+		}{{"30 1 * * *", "2024-11-03T01:30:00-04:00", true}, {"30 1 * * *", "2024-11-03T01:30:00-05:00", false}, {"30 2 * * *", "2024-03-10T03:00:00-04:00", true}} {
+			at, err := time.Parse(time.RFC3339, test.at)
+			abort(err)
+			if due, err := gron.IsDue(test.expr, at.In(loc)); err != nil || due != test.expect {
+				t.Errorf("%s at %s: expected %v, got %v, %v", test.expr, test.at, test.expect, due, err)
+			}
+		}
+	})
+
+	t.Run("fast due allocs", func(t *testing.T) {
+		gron := New()
+		for _, expr := range []string{"5 9 * * 1-5", "*/15 9-17 1,15 * 1-5", "0 0 1 1 *"} {
+			if n := testing.AllocsPerRun(100, func() { _, _ = gron.IsDue(expr, ref) }); n != 0 {
+				t.Errorf("%s: expected 0 allocs, got %v", expr, n)
+			}
+		}
+	})
+}
+
+func BenchmarkIsDueAllocs(b *testing.B) {
+	ref, gron := time.Date(2024, time.June, 3, 9, 5, 0, 0, time.UTC), New()
+	for _, expr := range []string{"5 9 * * 1-5", "*/15 9-17 1,15 * 1-5", "5 9 * * MON-FRI", "0 0 L * *"} {
+		b.Run(strconv.Quote(expr), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				_, _ = gron.IsDue(expr, ref)
+			}
+		})
+	}
+}
@@ -0,0 +1,176 @@
+package gronx
+
+import "strings"
+
+// Field is the cron part of Expression at its position, as checked (eg: 1-5) and as written in
+// expr (eg: MON-FRI), for inspecting it without handling the text of expr.
+type Field struct {
+	pos   int
+	value string
+	raw   string
+}
+
+// Minute gives the minute field.
+func (e *Expression) Minute() Field { return e.field(PosMinute) }
+
+// Hour gives the hour field.
+func (e *Expression) Hour() Field { return e.field(PosHour) }
+
+// DayOfMonth gives the day of month field.
+func (e *Expression) DayOfMonth() Field { return e.field(PosDayOfMonth) }
+
+// Month gives the month field.
+func (e *Expression) Month() Field { return e.field(PosMonth) }
+
+// DayOfWeek gives the week day field.
+func (e *Expression) DayOfWeek() Field { return e.field(PosDayOfWeek) }
+
+// Year gives the year field, and whether it was written in expr.
+func (e *Expression) Year() (Field, bool) { return e.field(PosYear), e.written(PosYear) }
+
+// Second gives the seconds field, and whether it was written in expr (WithSecondsField).
+func (e *Expression) Second() (Field, bool) { return e.field(PosSecond), e.written(PosSecond) }
+
+// field gives the field at given position, which is empty for @every interval.
+func (e *Expression) field(pos int) Field {
+	field := Field{pos: pos}
+	if pos < len(e.segs) {
+		field.value = e.segs[pos]
+	}
+	if pos < len(e.given) {
+		field.raw = e.given[pos]
+	}
+
+	return field
+}
+
+// written checks if the field at given position was written in expr.
+func (e *Expression) written(pos int) bool {
+	return pos < len(e.given) && e.given[pos] != ""
+}
+
+// String gives the expression as written, with single space between fields and the CRON_TZ=
+// prefix if it has its own time zone, so that it parses back to the same.
+func (e *Expression) String() string {
+	expr := strings.Join(SpaceRe.Split(strings.Trim(e.expr, " \t"), -1), " ")
+	if e.loc != nil && e.loc != e.gron.loc {
+		return "CRON_TZ=" + e.loc.String() + " " + expr
+	}
+
+	return expr
+}
+
+// Pos gives the position of field, one of Pos constants.
+func (f Field) Pos() int { return f.pos }
+
+// Value gives the field as checked, with names replaced and R, ~ and H resolved.
+func (f Field) Value() string { return f.value }
+
+// Raw gives the field as written in expr, with macro expanded.
+func (f Field) Raw() string { return f.raw }
+
+// String gives the field as written.
+func (f Field) String() string { return f.raw }
+
+// HasNames checks if the field was written with names of month or week day (eg: JAN, MON).
+func (f Field) HasNames() bool {
+	return f.unnamed() != strings.ToUpper(f.raw)
+}
+
+// unnamed gives the field as written in uppercase, with names of month or week day replaced.
+func (f Field) unnamed() string {
+	raw := strings.ToUpper(f.raw)
+	switch f.pos {
+	case PosMonth:
+		return replaceNames(raw, months)
+	case PosDayOfWeek:
+		return replaceNames(raw, days)
+	}
+
+	return raw
+}
+
+// HasRanges checks if the field was written with any range (eg: 1-5), other than the offset
+// from last day of month (eg: L-3).
+func (f Field) HasRanges() bool {
+	for _, offset := range strings.Split(f.raw, ",") {
+		if strings.Contains(offset, "-") && !strings.HasPrefix(strings.ToUpper(offset), "L") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// HasSteps checks if the field was written with any step (eg: */5).
+func (f Field) HasSteps() bool {
+	return strings.Contains(f.raw, "/")
+}
+
+// Values gives the values the field is due for, sorted without duplicates, with the ranges and
+// steps expanded and sunday as 0. The dynamic tokens (see HasDynamicTokens) whose values depend
+// on the month or year (eg: L, 15W, 5#2) are left out, so it is only the static portion then.
+// The field not written (eg: year of 5 fields) is due for all.
+func (f Field) Values() []int {
+	min, max := bounds[f.pos][0], bounds[f.pos][1]
+	if f.pos == PosYear {
+		min, max = valueBounds(PosYear)
+	}
+
+	values := []int{}
+	offsets := strings.Split(f.value, ",")
+	for val := min; val <= max; val++ {
+		for _, offset := range offsets {
+			if f.due(offset, val, min, max) {
+				values = append(values, val)
+				break
+			}
+		}
+	}
+
+	return values
+}
+
+// due checks if static offset of the field is due for val within min and max.
+func (f Field) due(offset string, val, min, max int) bool {
+	if offset == "" || offset == "*" || offset == "?" {
+		return true
+	}
+	if !canonicalRe.MatchString(offset) {
+		return false
+	}
+
+	due, err := isOffsetDue(offset, val, min, max)
+	if !due && err == nil && f.pos == PosDayOfWeek && val == 0 {
+		// Sunday is 7 too.
+		due, _ = isOffsetDue(offset, 7, min, max)
+	}
+
+	return due
+}
+
+// IsWildcard checks if the field is due for all values, ie * or ? or not written.
+func (f Field) IsWildcard() bool {
+	return f.value == "" || f.value == "*" || f.value == "?"
+}
+
+// Contains checks if v is one of Values, where 7 is sunday as well for week day.
+func (f Field) Contains(v int) bool {
+	if f.pos == PosDayOfWeek && v == 7 {
+		v = 0
+	}
+	for _, val := range f.Values() {
+		if val == v {
+			return true
+		}
+	}
+
+	return false
+}
+
+// HasDynamicTokens checks if the field has tokens whose values depend on the month or year
+// (L, W and #), or that were resolved to values as per hash key or seed (H, R and ~), which
+// Values can't tell as written.
+func (f Field) HasDynamicTokens() bool {
+	return strings.ContainsAny(strings.ToUpper(f.value), "LW#") || strings.ContainsAny(f.unnamed(), "HR~")
+}
@@ -0,0 +1,162 @@
+package gronx
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestExpressionFields(t *testing.T) {
+	t.Run("fields value raw", func(t *testing.T) {
+		e, err := Parse("*/15 9-17 L * MON-FRI")
+		abort(err)
+
+		fields := []Field{e.Minute(), e.Hour(), e.DayOfMonth(), e.Month(), e.DayOfWeek()}
+		values, raws := []string{"*/15", "9-17", "L", "*", "1-5"}, []string{"*/15", "9-17", "L", "*", "MON-FRI"}
+		for pos, field := range fields {
+			if field.Pos() != pos || field.Value() != values[pos] || field.Raw() != raws[pos] || field.String() != raws[pos] {
+				t.Errorf("%d: expected %s as %s, got %d %s as %s", pos, values[pos], raws[pos], field.Pos(), field.Value(), field.Raw())
+			}
+		}
+		if _, ok := e.Year(); ok {
+			t.Errorf("expected no year")
+		}
+		if _, ok := e.Second(); ok {
+			t.Errorf("expected no second")
+		}
+	})
+
+	t.Run("fields style", func(t *testing.T) {
+		e, err := Parse("0,30 */2 L-3 jan-mar/2 SUN,5")
+		abort(err)
+
+		tests := []struct {
+			field                Field
+			names, ranges, steps bool
+		}{
+			{e.Minute(), false, false, false},
+			{e.Hour(), false, false, true},
+			{e.DayOfMonth(), false, false, false},
+			{e.Month(), true, true, true},
+			{e.DayOfWeek(), true, false, false},
+		}
+		for _, test := range tests {
+			f := test.field
+			if f.HasNames() != test.names || f.HasRanges() != test.ranges || f.HasSteps() != test.steps {
+				t.Errorf("%s: expected names %v ranges %v steps %v, got %v %v %v", f.Raw(), test.names, test.ranges, test.steps, f.HasNames(), f.HasRanges(), f.HasSteps())
+			}
+		}
+	})
+
+	t.Run("fields year second", func(t *testing.T) {
+		gron := New()
+		e, err := gron.Parse("0 0 1 1 * 2030-2035")
+		abort(err)
+		if year, ok := e.Year(); !ok || year.Value() != "2030-2035" || !year.HasRanges() {
+			t.Errorf("expected year 2030-2035, got %v, %v", year.Value(), ok)
+		}
+
+		seconds := New(WithSecondsField())
+		if e, err = seconds.Parse("*/10 5 * * * *"); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if second, ok := e.Second(); !ok || second.Raw() != "*/10" || e.Minute().Raw() != "5" {
+			t.Errorf("expected second */10 and minute 5, got %v, %v, %v", second.Raw(), ok, e.Minute().Raw())
+		}
+	})
+
+	t.Run("fields round trip", func(t *testing.T) {
+		for _, expr := range []string{"*/15  9-17 * *  MON-FRI", "CRON_TZ=Asia/Tokyo 30 9 * * *", "@daily", "0 0 1 1 * 2030", "@every 90m"} {
+			e, err := Parse(expr)
+			abort(err)
+			again, err := Parse(e.String())
+			if err != nil || again.String() != e.String() || !reflect.DeepEqual(again.segs, e.segs) || again.loc != e.loc {
+				t.Errorf("%s: expected %s to parse back the same, got %v, %v", expr, e.String(), again, err)
+			}
+		}
+		if e, _ := Parse("*/15  9-17 * *  MON-FRI"); e.String() != "*/15 9-17 * * MON-FRI" {
+			t.Errorf("expected single spaced, got %s", e.String())
+		}
+		if e, _ := Parse("@daily"); e.Hour().Raw() != "0" || e.String() != "@daily" {
+			t.Errorf("expected macro expanded in fields, got %s of %s", e.Hour().Raw(), e.String())
+		}
+	})
+}
+
+func TestFieldValues(t *testing.T) {
+	tests := []struct {
+		expr     string
+		pos      int
+		values   string
+		wildcard bool
+		dynamic  bool
+	}{
+		{"5 * * * *", PosMinute, "[5]", false, false},
+		{"5,1,5 * * * *", PosMinute, "[1 5]", false, false},
+		{"50-55 * * * *", PosMinute, "[50 51 52 53 54 55]", false, false},
+		{"*/15 * * * *", PosMinute, "[0 15 30 45]", false, false},
+		{"10-30/10 * * * *", PosMinute, "[10 20 30]", false, false},
+		{"* * * * *", PosMinute, fmt.Sprint(seq(0, 59)), true, false},
+		{"0 H * * *", PosHour, "", false, true},
+		{"0 20-23,0-2 * * *", PosHour, "[0 1 2 20 21 22 23]", false, false},
+		{"0 */6 * * *", PosHour, "[0 6 12 18]", false, false},
+		{"0 0 ? * *", PosDayOfMonth, fmt.Sprint(seq(1, 31)), true, false},
+		{"0 0 1,L * *", PosDayOfMonth, "[1]", false, true},
+		{"0 0 15W * *", PosDayOfMonth, "[]", false, true},
+		{"0 0 L-3 * *", PosDayOfMonth, "[]", false, true},
+		{"0 0 1-31/10 * *", PosDayOfMonth, "[1 11 21 31]", false, false},
+		{"0 0 1 JAN-MAR,dec *", PosMonth, "[1 2 3 12]", false, false},
+		{"0 0 1 */4 *", PosMonth, "[1 5 9]", false, false},
+		{"0 0 * * MON-FRI", PosDayOfWeek, "[1 2 3 4 5]", false, false},
+		{"0 0 * * 7,SAT", PosDayOfWeek, "[0 6]", false, false},
+		{"0 0 * * FRI-SUN", PosDayOfWeek, "[0 5 6]", false, false},
+		{"0 0 * * 5L", PosDayOfWeek, "[]", false, true},
+		{"0 0 * * 1,5#2", PosDayOfWeek, "[1]", false, true},
+		{"0 0 * * THU", PosDayOfWeek, "[4]", false, false},
+		{"0 0 1 1 * 2030-2034/2", PosYear, "[2030 2032 2034]", false, false},
+	}
+
+	gron := New(WithHashKey("job"))
+	for _, test := range tests {
+		t.Run("field values "+test.expr, func(t *testing.T) {
+			e, err := gron.Parse(test.expr)
+			abort(err)
+
+			f := e.field(test.pos)
+			values := fmt.Sprint(f.Values())
+			if test.values == "" {
+				// Resolved as per hash key.
+				test.values = "[" + f.Value() + "]"
+			}
+			if values != test.values || f.IsWildcard() != test.wildcard || f.HasDynamicTokens() != test.dynamic {
+				t.Errorf("expected %s, wildcard %v, dynamic %v, got %s, %v, %v", test.values, test.wildcard, test.dynamic, values, f.IsWildcard(), f.HasDynamicTokens())
+			}
+			for _, v := range f.Values() {
+				if !f.Contains(v) {
+					t.Errorf("expected %d contained", v)
+				}
+			}
+		})
+	}
+
+	t.Run("field contains", func(t *testing.T) {
+		e, err := gron.Parse("0 0 * * SUN")
+		abort(err)
+		if dow := e.DayOfWeek(); !dow.Contains(0) || !dow.Contains(7) || dow.Contains(1) {
+			t.Errorf("expected sunday as 0 and 7 only, got %v", dow.Values())
+		}
+		if year, _ := e.Year(); !year.IsWildcard() || !year.Contains(2030) {
+			t.Errorf("expected year not written as wildcard")
+		}
+	})
+}
+
+// seq gives the ints from min to max.
+func seq(min, max int) []int {
+	out := []int{}
+	for n := min; n <= max; n++ {
+		out = append(out, n)
+	}
+
+	return out
+}
@@ -0,0 +1,116 @@
+package gronx
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"time"
+)
+
+// fingerprintVersion tags the form hashed by Fingerprint. It is kept as is so that fingerprints
+// stay the same across versions of gronx, where new constructs only add to the form.
+const fingerprintVersion = "gronx/fingerprint/v1"
+
+// Fingerprint gives the stable identity of cron expr as the hex of first 16 bytes of SHA-256 of
+// its canonical form like Canonicalize, so that the exprs due at the same times like @hourly,
+// 0 * * * * and 0-0 */1 * * * have the same fingerprint. It also has the zone of CRON_TZ= prefix.
+// The fingerprint of expr never changes across versions of gronx. It returns error if expr is invalid.
+func Fingerprint(expr string) (string, error) {
+	expr, loc, err := exprZone(expr)
+	if err != nil {
+		return "", err
+	}
+	if every, ok, err := parseEvery(expr); ok {
+		if err != nil {
+			return "", err
+		}
+		return fingerprint(loc, everyForm(every, time.Time{})), nil
+	}
+	if err := Validate(expr); err != nil {
+		return "", err
+	}
+
+	segs, err := Segments(expr)
+	if errors.Is(err, ErrReboot) {
+		return fingerprint(loc, "@reboot"), nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return fingerprint(loc, segmentsForm(segs, false, false)), nil
+}
+
+// Fingerprint gives the stable identity of cron expr like Fingerprint as per the options of
+// Gronx that change when it is due, ie the layout of segments, the resolved R, ~ and H, WithDayOr
+// if both days are restricted, WithLocation and WithAnchor of @every. So the exprs with the same
+// fingerprint are due at the same times, even if they are for Gronx with other options.
+func (g *Gronx) Fingerprint(expr string) (string, error) {
+	expr, loc, err := g.zone(expr)
+	if err != nil {
+		return "", err
+	}
+	if every, ok, err := g.every(expr); ok {
+		if err != nil {
+			return "", err
+		}
+		return fingerprint(loc, everyForm(every, g.anchor)), nil
+	}
+
+	segs, err := g.segments(expr)
+	if errors.Is(err, ErrReboot) {
+		return fingerprint(loc, "@reboot"), nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return fingerprint(loc, segmentsForm(segs, g.dayOr, g.seconds)), nil
+}
+
+// fingerprint gives the hex of first 16 bytes of SHA-256 of form in loc.
+func fingerprint(loc *time.Location, form string) string {
+	zone := ""
+	if loc != nil {
+		zone = loc.String()
+	}
+
+	sum := sha256.Sum256([]byte(fingerprintVersion + "\n" + zone + "\n" + form))
+
+	return hex.EncodeToString(sum[:16])
+}
+
+// everyForm gives the form of @every interval from anchor to fingerprint.
+func everyForm(every time.Duration, anchor time.Time) string {
+	form := "@every " + every.String()
+	if !anchor.IsZero() {
+		form += " from " + anchor.UTC().Format(time.RFC3339Nano)
+	}
+
+	return form
+}
+
+// segmentsForm gives the form of cron segments to fingerprint: their canonical form with ? as *,
+// year if restricted, seconds if enabled and whether either day is due if dayOr.
+func segmentsForm(segs []string, dayOr, seconds bool) string {
+	out := canonicalSegments(segs, dayOr)
+	for pos, seg := range out {
+		if seg == "?" {
+			out[pos] = "*"
+		}
+	}
+
+	form := strings.Join(out[:PosYear], " ")
+	if len(out) > PosYear && out[PosYear] != "*" {
+		form += " year " + out[PosYear]
+	}
+	if seconds {
+		form += " second " + out[PosSecond]
+	}
+	if dayOr && dayRestricted(out) {
+		form += " day or"
+	}
+
+	return form
+}
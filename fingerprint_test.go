@@ -0,0 +1,144 @@
+package gronx
+
+import (
+	"bufio"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFingerprintGolden(t *testing.T) {
+	file, err := os.Open("testdata/fingerprint.golden")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	defer file.Close()
+
+	lines := bufio.NewScanner(file)
+	for lines.Scan() {
+		line := lines.Text()
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.SplitN(line, "\t", 2)
+		expr, expect := fields[0], fields[len(fields)-1]
+		t.Run("fingerprint "+expr, func(t *testing.T) {
+			actual, err := Fingerprint(expr)
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if actual != expect {
+				t.Errorf("expected %s, got %s", expect, actual)
+			}
+		})
+	}
+}
+
+func TestFingerprint(t *testing.T) {
+	same := [][]string{
+		{"@hourly", "0 * * * *", "0 * * * * ", " 0  *\t* * *", "0 * * * * *"},
+		{"0 0-23 * * *", "0-0 */1 * * *", "0 0-23 1-31 JAN-DEC *"},
+		{"0 0 * * 1-5", "@weekday", "0 0 * * MON,TUE,WED-FRI", "0 0 ? * 1-5"},
+		{"0 0 * * 0", "0 0 * * 7", "0 0 * * sun"},
+		{"TZ=UTC 0 0 * * *", "CRON_TZ=UTC @daily"},
+		{"@every 1h", "@every 60m"},
+	}
+	for _, exprs := range same {
+		t.Run("same fingerprint "+exprs[0], func(t *testing.T) {
+			expect, err := Fingerprint(exprs[0])
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			for _, expr := range exprs[1:] {
+				if actual, err := Fingerprint(expr); err != nil || actual != expect {
+					t.Errorf("expected %s for %s, got %s (%v)", expect, expr, actual, err)
+				}
+			}
+		})
+	}
+
+	t.Run("other fingerprint", func(t *testing.T) {
+		seen := map[string]string{}
+		for _, expr := range []string{"0 * * * *", "0 0-23 * * *", "*/30 * * * *", "*/30 0-23 * * *", "0 0 * * *", "CRON_TZ=UTC 0 0 * * *", "0 0 1 * *", "0 0 L * *", "0 0 * * * 2030", "@every 1h", "@reboot"} {
+			actual, err := Fingerprint(expr)
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if other, ok := seen[actual]; ok {
+				t.Errorf("expected other fingerprint for %s than %s, got %s", expr, other, actual)
+			}
+			seen[actual] = expr
+		}
+	})
+
+	t.Run("fingerprint invalid", func(t *testing.T) {
+		for _, expr := range []string{"61 * * * *", "@every 1x", "TZ=Mars/Base * * * * *", "H * * * *"} {
+			if _, err := Fingerprint(expr); err == nil {
+				t.Errorf("expected error for %s, got nil", expr)
+			}
+		}
+	})
+}
+
+func TestGronxFingerprint(t *testing.T) {
+	fp := func(t *testing.T, expr string, opts ...Option) string {
+		t.Helper()
+		gron := New(opts...)
+		actual, err := gron.Fingerprint(expr)
+		if err != nil {
+			t.Fatalf("expected no error for %s, got %v", expr, err)
+		}
+		return actual
+	}
+
+	t.Run("same as stateless", func(t *testing.T) {
+		for _, expr := range []string{"0 * * * *", "0 0 ? * 5#2", "CRON_TZ=Asia/Tokyo 30 4 * * *", "@every 90m"} {
+			if expect, _ := Fingerprint(expr); fp(t, expr) != expect {
+				t.Errorf("expected %s for %s, got %s", expect, expr, fp(t, expr))
+			}
+		}
+	})
+
+	t.Run("same across options", func(t *testing.T) {
+		pairs := [][2]string{
+			{fp(t, "0 * * * *"), fp(t, "0 * * * *", WithDayOr())},
+			{fp(t, "0 * * * *"), fp(t, "0 * * * *", WithExclusive())},
+			{fp(t, "0 0 13 * *"), fp(t, "0 0 13 * *", WithDayOr())},
+			{fp(t, "0 0 * * 0"), fp(t, "0 0 * * 7", WithISOWeekday())},
+			{fp(t, "0 0 0 * * 0", WithSecondsField()), fp(t, "0 0 0 ? * 1", WithQuartz())},
+			{fp(t, "0 0 * * 1"), fp(t, "@weekly", WithWeekStart(time.Monday))},
+			{fp(t, "H 0 * * *", WithHashKey("job")), fp(t, "H 0 * * *", WithHashKey("job"))},
+		}
+		for i, pair := range pairs {
+			if pair[0] != pair[1] {
+				t.Errorf("expected same fingerprint for #%d, got %s and %s", i, pair[0], pair[1])
+			}
+		}
+	})
+
+	t.Run("other across options", func(t *testing.T) {
+		pairs := [][2]string{
+			{fp(t, "0 0 13 * 5"), fp(t, "0 0 13 * 5", WithDayOr())},
+			{fp(t, "0 0 * * *"), fp(t, "0 0 0 * * *", WithSecondsField())},
+			{fp(t, "0 0 * * *"), fp(t, "0 0 * * *", WithLocation(time.UTC))},
+			{fp(t, "@every 1h"), fp(t, "@every 1h", WithAnchor(time.Date(2024, 1, 1, 0, 30, 0, 0, time.UTC)))},
+			{fp(t, "0 0 * * 0"), fp(t, "@weekly", WithWeekStart(time.Monday))},
+			{fp(t, "H 0 * * *", WithHashKey("job")), fp(t, "H 0 * * *", WithHashKey("other"))},
+		}
+		for i, pair := range pairs {
+			if pair[0] == pair[1] {
+				t.Errorf("expected other fingerprints for #%d, got %s", i, pair[0])
+			}
+		}
+	})
+}
+
+func BenchmarkFingerprint(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		if _, err := Fingerprint("0,30 9-17 * * MON-FRI"); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
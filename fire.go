@@ -0,0 +1,96 @@
+package gronx
+
+import (
+	"errors"
+	"time"
+)
+
+// cycleYear and cycleYears are the start and length of one full cycle of leap years and week
+// days within bounds of year segment, so any day that is possible at all is there.
+const cycleYear, cycleYears = 2000, 28
+
+// WillEverFire checks if cron expr can ever be due, ie that some day of the allowed years and
+// months matches day of month and week day (eg: `0 0 30 2 *` never does). It checks the days
+// instead of stepping through time, with leap years as per year segment if any.
+// The @reboot and @every are always due. It returns bool or error if the expr is invalid.
+func WillEverFire(expr string) (bool, error) {
+	expr, _, err := exprZone(expr)
+	if err != nil {
+		return false, err
+	}
+
+	segs, err := Segments(expr)
+	if errors.Is(err, ErrReboot) {
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return willFire(segs, checkDue, defaultYears)
+}
+
+// WillEverFire checks if cron expr can ever be due as per the options of Gronx, like the
+// stateless WillEverFire. It returns bool or error if the expr is invalid.
+func (g *Gronx) WillEverFire(expr string) (bool, error) {
+	expr, _, err := exprZone(expr)
+	if err != nil {
+		return false, err
+	}
+	if _, ok, err := g.every(expr); ok {
+		return err == nil, err
+	}
+
+	segs, err := g.segments(expr)
+	if errors.Is(err, ErrReboot) {
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return willFire(segs, g.dueFor(segs), g.yearRange())
+}
+
+// willFire checks if any day of the years allowed by segs within years is due as per due, with
+// the time of day taken as due since any value within bounds of those segments exists every day.
+func willFire(segs []string, due dueFunc, years yearRange) (bool, error) {
+	days := append([]string{"*", "*"}, segs[PosDayOfMonth:]...)
+	if len(days) > PosSecond {
+		days[PosSecond] = "*"
+	}
+
+	lo, hi := cycleYear, cycleYear+cycleYears-1
+	if dow := segs[PosDayOfWeek]; dow == "*" || dow == "?" {
+		// The leap year has all the days of month.
+		hi = lo
+	}
+	if len(segs) > PosYear && segs[PosYear] != "*" {
+		lo, hi = years.min, years.max
+		if min, max, ok, _ := yearSpan(segs[PosYear], anyYears); ok {
+			lo, hi = maxInt(min, lo), minInt(max, hi)
+		}
+	}
+
+	for year := lo; year <= hi; year++ {
+	months:
+		for month := time.January; month <= time.December; month++ {
+			for day := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC); day.Month() == month; day = day.AddDate(0, 0, 1) {
+				pos, err := undueSegment(days, day, due)
+				switch {
+				case err != nil:
+					return false, err
+				case pos < 0:
+					return true, nil
+				case pos == PosYear:
+					month = time.December
+					continue months
+				case pos == PosMonth:
+					continue months
+				}
+			}
+		}
+	}
+
+	return false, nil
+}
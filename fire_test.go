@@ -0,0 +1,65 @@
+package gronx
+
+import "testing"
+
+func TestWillEverFire(t *testing.T) {
+	tests := map[string]bool{
+		"* * * * *":            true,
+		"0 0 30 2 *":           false,
+		"0 0 31 4,6,9,11 *":    false,
+		"0 0 31 4,6,9,11,12 *": true,
+		"0 0 29 2 *":           true,
+		"0 0 29 2 * 2024":      true,
+		"0 0 29 2 * 2025":      false,
+		"0 0 29 2 * 2025-2027": false,
+		"0 0 29 2 * 2025-2028": true,
+		"0 0 29 2 * 2097/2":    false,
+		"0 0 13 * 5":           true,
+		"0 0 1 1 1 2025":       false,
+		"0 0 1 1 1 2024":       true,
+		"0 0 29 2 1 2024":      false,
+		"0 0 29 2 4 2024":      true,
+		"0 0 L 2 *":            true,
+		"0 0 * * 5#5 2021":     true,
+		"0 0 * 2 1#5 2021":     false,
+		"@reboot":              true,
+		"@yearly":              true,
+	}
+
+	for expr, expect := range tests {
+		t.Run("will ever fire "+expr, func(t *testing.T) {
+			actual, err := WillEverFire(expr)
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if actual != expect {
+				t.Errorf("expected %v, got %v", expect, actual)
+			}
+		})
+	}
+
+	t.Run("will ever fire err", func(t *testing.T) {
+		if _, err := WillEverFire("0 0 32 * *"); err == nil {
+			t.Errorf("expected error, got nil")
+		}
+	})
+
+	t.Run("will ever fire gronx", func(t *testing.T) {
+		gron, dayOr, secs := New(), New(WithDayOr()), New(WithSecondsField())
+		if ok, err := gron.WillEverFire("@every 1h"); err != nil || !ok {
+			t.Errorf("expected true, got %v, %v", ok, err)
+		}
+		if ok, err := gron.WillEverFire("0 0 1 1 1 2025"); err != nil || ok {
+			t.Errorf("expected false, got %v, %v", ok, err)
+		}
+		if ok, err := dayOr.WillEverFire("0 0 1 1 1 2025"); err != nil || !ok {
+			t.Errorf("expected true with day or, got %v, %v", ok, err)
+		}
+		if ok, err := secs.WillEverFire("30 0 0 30 2 *"); err != nil || ok {
+			t.Errorf("expected false, got %v, %v", ok, err)
+		}
+		if _, err := gron.WillEverFire("@every 1x"); err == nil {
+			t.Errorf("expected error, got nil")
+		}
+	})
+}
@@ -0,0 +1,102 @@
+package gronx
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// PreviewSchedule gets the next n due times for given cron expr on or after reference time
+// formatted with given layout (RFC3339 if empty) in the location of reference time.
+// Weekday names are rendered as usual when the layout has them, eg: "Mon 2006-01-02 15:04".
+func (g *Gronx) PreviewSchedule(expr string, ref time.Time, n int, layout string) ([]string, error) {
+	if layout == "" {
+		layout = time.RFC3339
+	}
+
+	times, err := g.GetNextN(expr, ref, n)
+	if err != nil {
+		return nil, fmt.Errorf("can't preview cron expression %q: %w", expr, err)
+	}
+
+	preview := make([]string, len(times))
+	for i, next := range times {
+		preview[i] = next.In(ref.Location()).Format(layout)
+	}
+
+	return preview, nil
+}
+
+var humanUnits = []struct {
+	name string
+	dur  time.Duration
+}{
+	{"day", 24 * time.Hour},
+	{"hour", time.Hour},
+	{"minute", time.Minute},
+	{"second", time.Second},
+}
+
+// HumanizeUntilNext gets the duration from reference time until the next due time of given
+// cron expr in words, eg: "in 2 hours 5 minutes" or "due now". Seconds are dropped when it
+// is over an hour away and minutes when over a day away, rounding to the smallest unit shown.
+// The optional precision limits how many units are shown, eg: 1 gives "in 2 hours".
+func (g *Gronx) HumanizeUntilNext(expr string, ref time.Time, precision ...int) (string, error) {
+	dur, err := g.TimeUntilNext(expr, ref)
+	if err != nil {
+		return "", err
+	}
+
+	return humanize(dur, precision...), nil
+}
+
+func humanize(dur time.Duration, precision ...int) string {
+	if dur <= 0 {
+		return "due now"
+	}
+
+	units := humanUnits
+	if dur >= 24*time.Hour {
+		units = units[:2]
+	} else if dur >= time.Hour {
+		units = units[:3]
+	}
+
+	max := len(units)
+	if len(precision) > 0 && precision[0] > 0 && precision[0] < max {
+		max = precision[0]
+	}
+
+	first := 0
+	for first < len(units)-1 && dur < units[first].dur {
+		first++
+	}
+
+	last := first + max - 1
+	if last >= len(units) {
+		last = len(units) - 1
+	}
+
+	// Rounding may carry over to bigger unit, so humanize the rounded duration afresh.
+	if rounded := dur.Round(units[last].dur); rounded != dur {
+		return humanize(rounded, precision...)
+	}
+
+	parts := []string{}
+	for _, unit := range units[first : last+1] {
+		if n := dur / unit.dur; n > 0 {
+			parts = append(parts, plural(int(n), unit.name))
+		}
+		dur %= unit.dur
+	}
+
+	return "in " + strings.Join(parts, " ")
+}
+
+func plural(n int, unit string) string {
+	if n == 1 {
+		return "1 " + unit
+	}
+
+	return fmt.Sprintf("%d %ss", n, unit)
+}
@@ -0,0 +1,87 @@
+package gronx
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestPreviewSchedule(t *testing.T) {
+	gron := New()
+	ref, _ := time.Parse(dateFormat, "2021-04-19 12:54:09")
+
+	t.Run("preview schedule", func(t *testing.T) {
+		preview, err := gron.PreviewSchedule("0 9 * * MON", ref, 2, "Monday 2006-01-02 15:04")
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		expect := "Monday 2021-04-26 09:00,Monday 2021-05-03 09:00"
+		if actual := strings.Join(preview, ","); actual != expect {
+			t.Errorf("expected %v, got %v", expect, actual)
+		}
+	})
+
+	t.Run("preview schedule default layout", func(t *testing.T) {
+		loc := time.FixedZone("IST", 19800)
+		preview, err := gron.PreviewSchedule("@daily", ref.In(loc), 1, "")
+		if err != nil || len(preview) != 1 || preview[0] != "2021-04-20T00:00:00+05:30" {
+			t.Errorf("expected 2021-04-20T00:00:00+05:30, got %v, %v", preview, err)
+		}
+	})
+
+	t.Run("preview schedule invalid", func(t *testing.T) {
+		preview, err := gron.PreviewSchedule("* * *", ref, 5, "")
+		if err == nil || !strings.Contains(err.Error(), `"* * *"`) {
+			t.Errorf("expected error with expr, got %v", err)
+		}
+		if preview != nil {
+			t.Errorf("expected nil, got %v", preview)
+		}
+	})
+}
+
+func TestHumanizeUntilNext(t *testing.T) {
+	gron := New()
+	tests := []struct {
+		expr, ref string
+		precision []int
+		expect    string
+	}{
+		{"5 10 * * *", "2021-04-19 10:05:00", nil, "due now"},
+		{"5 10 * * *", "2021-04-19 10:05:37", nil, "due now"},
+		{"5 10 * * *", "2021-04-19 10:04:37", nil, "in 23 seconds"},
+		{"5 10 * * *", "2021-04-19 10:00:37", nil, "in 4 minutes 23 seconds"},
+		{"5 10 * * *", "2021-04-19 10:00:37", []int{1}, "in 4 minutes"},
+		{"5 10 * * *", "2021-04-19 10:01:00", nil, "in 4 minutes"},
+		{"5 10 * * *", "2021-04-19 08:00:00", nil, "in 2 hours 5 minutes"},
+		{"5 10 * * *", "2021-04-19 08:00:31", nil, "in 2 hours 4 minutes"},
+		{"5 10 * * *", "2021-04-19 08:04:00", []int{1}, "in 2 hours"},
+		{"5 10 * * *", "2021-04-19 08:35:00", []int{1}, "in 2 hours"},
+		{"5 10 * * *", "2021-04-19 09:04:40", nil, "in 1 hour"},
+		{"5 10 * * *", "2021-04-19 10:04:59", []int{1}, "in 1 second"},
+		{"0 0 1 * *", "2021-04-27 13:00:00", nil, "in 3 days 11 hours"},
+		{"0 0 1 * *", "2021-04-27 13:00:00", []int{1}, "in 3 days"},
+		{"0 0 1 * *", "2021-04-28 12:00:00", []int{1}, "in 3 days"},
+		{"0 0 1 * *", "2021-04-29 23:40:00", nil, "in 1 day"},
+	}
+
+	for _, test := range tests {
+		t.Run("humanize until next "+test.expr+" "+test.ref, func(t *testing.T) {
+			ref, _ := time.Parse(dateFormat, test.ref)
+			actual, err := gron.HumanizeUntilNext(test.expr, ref, test.precision...)
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if actual != test.expect {
+				t.Errorf("expected %v, got %v", test.expect, actual)
+			}
+		})
+	}
+
+	t.Run("humanize until next invalid", func(t *testing.T) {
+		if _, err := gron.HumanizeUntilNext("* * *", time.Now()); err == nil {
+			t.Errorf("expected error, got nil")
+		}
+	})
+}
@@ -0,0 +1,109 @@
+package gronx
+
+import "time"
+
+// frequencyYears is the years of Gregorian cycle, over which the week days follow the same
+// course again, as its days are whole weeks.
+const frequencyYears = 400
+
+// Rate is how many times on average the expression is due per hour, day and week of wall
+// clock, where it is Approximate if that depends on the length of months or years.
+type Rate struct {
+	PerHour     float64
+	PerDay      float64
+	PerWeek     float64
+	Approximate bool
+}
+
+// Frequency gives how many times on average cron expr is due per hour, day and week like
+// Expression.Frequency, without telling if it is approximate. It returns error if expr is invalid.
+func Frequency(expr string) (perHour, perDay, perWeek float64, err error) {
+	e, err := Parse(expr)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	rate := e.Frequency()
+
+	return rate.PerHour, rate.PerDay, rate.PerWeek, nil
+}
+
+// Frequency gives how many times on average the expression is due per hour, day and week of wall
+// clock, ignoring DST transitions. It is exact for @every and for the ones due on every day or on
+// given week days alike, as the due times of a day multiplied out. Otherwise it is the average
+// over the due years, or over the Gregorian cycle of 400 years if any year is due, and is
+// flagged Approximate.
+func (e *Expression) Frequency() Rate {
+	if e.every > 0 {
+		perHour := float64(time.Hour) / float64(e.every)
+		return Rate{PerHour: perHour, PerDay: perHour * 24, PerWeek: perHour * 24 * 7}
+	}
+
+	segs, times := e.segs, 1
+	if len(segs) == PosYear {
+		segs = append(segs[:PosYear:PosYear], "*")
+	}
+	for _, pos := range []int{PosMinute, PosHour, PosSecond} {
+		if pos < len(segs) {
+			values, _ := staticValues(segs[pos], pos)
+			times *= len(values)
+		}
+	}
+
+	weekly := true
+	for _, pos := range []int{PosMonth, PosYear, PosDayOfMonth} {
+		weekly = weekly && (segs[pos] == "*" || segs[pos] == "?")
+	}
+	if days, ok := staticValues(segs[PosDayOfWeek], PosDayOfWeek); weekly && ok {
+		perDay := float64(times*len(days)) / 7
+
+		return Rate{PerHour: perDay / 24, PerDay: perDay, PerWeek: perDay * 7}
+	}
+
+	years, _ := staticValues(segs[PosYear], PosYear)
+	if segs[PosYear] == "*" {
+		years = make([]int, frequencyYears)
+		for i := range years {
+			years[i] = 2000 + i
+		}
+	}
+
+	// The years alike have the same due days, ie the ones starting on the same week day and
+	// being leap or not, so only one of each kind is checked.
+	dayOr, due, count, days := e.gron.dayOr && dayRestricted(segs), map[int]int{}, 0, 0
+	for _, year := range years {
+		start := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+		size := int(start.AddDate(1, 0, 0).Sub(start) / (24 * time.Hour))
+		kind := int(start.Weekday())*2 + size - 365
+		if _, ok := due[kind]; !ok {
+			due[kind] = dueDays(segs, start, dayOr)
+		}
+		count, days = count+due[kind], days+size
+	}
+	perDay := float64(times) * float64(count) / float64(days)
+
+	return Rate{PerHour: perDay / 24, PerDay: perDay, PerWeek: perDay * 7, Approximate: true}
+}
+
+// dueDays counts the days of year from start that are due for the segments of date of segs,
+// where either day of month or week day is enough if dayOr.
+func dueDays(segs []string, start time.Time, dayOr bool) int {
+	either := [][]string{segs}
+	if dayOr {
+		dom, dow := append([]string{}, segs...), append([]string{}, segs...)
+		dom[PosDayOfWeek], dow[PosDayOfMonth] = "*", "*"
+		either = [][]string{dom, dow}
+	}
+
+	count := 0
+	for day := start; day.Year() == start.Year(); day = day.AddDate(0, 0, 1) {
+		for _, segs := range either {
+			if dayDue(segs, day) {
+				count++
+				break
+			}
+		}
+	}
+
+	return count
+}
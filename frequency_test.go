@@ -0,0 +1,152 @@
+package gronx
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestFrequency(t *testing.T) {
+	tests := []struct {
+		expr    string
+		perHour float64
+		perDay  float64
+		perWeek float64
+	}{
+		{"* * * * *", 60, 1440, 10080},
+		{"*/15 * * * *", 4, 96, 672},
+		{"0 9-17 * * *", 9.0 / 24, 9, 63},
+		{"0,30 9 * * 1-5", 10.0 / 7 / 24, 10.0 / 7, 10},
+		{"0 0 * * 0,7", 1.0 / 7 / 24, 1.0 / 7, 1},
+		{"0 0 ? * sun", 1.0 / 7 / 24, 1.0 / 7, 1},
+		{"@daily", 1.0 / 24, 1, 7},
+		{"@weekly", 1.0 / 7 / 24, 1.0 / 7, 1},
+		{"@every 20m", 3, 72, 504},
+		{"@every 90s", 40, 960, 6720},
+	}
+
+	for _, test := range tests {
+		t.Run("frequency "+test.expr, func(t *testing.T) {
+			e, err := Parse(test.expr)
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			rate := e.Frequency()
+			if rate.Approximate {
+				t.Error("expected exact")
+			}
+
+			perHour, perDay, perWeek, err := Frequency(test.expr)
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			for _, actual := range [][2]float64{{test.perHour, perHour}, {test.perDay, perDay}, {test.perWeek, perWeek}} {
+				if math.Abs(actual[0]-actual[1]) > 1e-9 {
+					t.Errorf("expected %v, got %v", actual[0], actual[1])
+				}
+			}
+		})
+	}
+
+	t.Run("frequency approximate", func(t *testing.T) {
+		for expr, perYear := range map[string]float64{
+			"0 0 1 * *":      12,
+			"0 0 29 2 *":     97.0 / 400,
+			"0 0 31 * *":     7,
+			"0 0 * 2 *":      28 + 97.0/400,
+			"0 0 1 1 * 2030": 1,
+		} {
+			e, err := Parse(expr)
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			rate, days := e.Frequency(), 365.2425
+			if expr == "0 0 1 1 * 2030" {
+				days = 365
+			}
+			if !rate.Approximate {
+				t.Errorf("expected approximate for %s", expr)
+			}
+			if math.Abs(rate.PerDay*days-perYear) > 1e-9 {
+				t.Errorf("expected %v, got %v", perYear, rate.PerDay*days)
+			}
+		}
+	})
+
+	t.Run("frequency seconds", func(t *testing.T) {
+		gron := New(WithSecondsField())
+		e, err := gron.Parse("*/10 0 0 * * *")
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if rate := e.Frequency(); rate.PerDay != 6 || rate.Approximate {
+			t.Errorf("expected %v, got %v", 6, rate.PerDay)
+		}
+	})
+
+	t.Run("frequency invalid", func(t *testing.T) {
+		for _, expr := range []string{"* * * *", "@reboot", "61 * * * *"} {
+			if _, _, _, err := Frequency(expr); err == nil {
+				t.Errorf("expected error for %s", expr)
+			}
+		}
+	})
+}
+
+func TestFrequencyCount(t *testing.T) {
+	start := time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(1, 0, 0).Add(-time.Minute)
+	tests := []struct {
+		expr string
+		opts []Option
+	}{
+		{"0 */2 * * *", nil},
+		{"15,45 9-17 * * mon-fri", nil},
+		{"0 12 * * 6L", nil},
+		{"0 0 L * *", nil},
+		{"0 8 15W * *", nil},
+		{"0 0 1-7 * 1", nil},
+		{"0 0 1-7 * 1", []Option{WithDayOr()}},
+		{"30 6 */10 */2 *", nil},
+		{"0 0 * * 5#3", nil},
+		{"0 0 13 * fri", nil},
+		{"0 0 13 * fri", []Option{WithDayOr()}},
+		{"*/30 * 1 jan,jul *", nil},
+		{"0 0 0 1 * ?", []Option{WithQuartz()}},
+	}
+
+	for _, test := range tests {
+		t.Run("frequency count "+test.expr, func(t *testing.T) {
+			gron := New(test.opts...)
+			count, err := gron.CountOccurrences(test.expr, start, end)
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			e, err := gron.Parse(test.expr)
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			rate := e.Frequency()
+			if rate != e.Frequency() {
+				t.Errorf("expected %v, got %v", rate, e.Frequency())
+			}
+
+			// The year may have a few more or less of the due days than the average one.
+			if actual := rate.PerDay * 365; math.Abs(actual-float64(count)) > math.Max(3, 0.02*float64(count)) {
+				t.Errorf("expected %v, got %v", count, actual)
+			}
+		})
+	}
+}
+
+func BenchmarkFrequency(b *testing.B) {
+	e, err := Parse("0 0 13 * fri")
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	for i := 0; i < b.N; i++ {
+		e.Frequency()
+	}
+}
@@ -0,0 +1,91 @@
+//go:build go1.18
+// +build go1.18
+
+package gronx
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+// fuzzSeeds are the cron expressions the fuzz tests start from.
+var fuzzSeeds = []string{
+	"* * * * *", "*/5 0-23/2 1,15,L * MON-FRI", "0 0 L-3 * 5L", "0 0 15W * 1#3", "0 0 1 1 * 2025-2030/2",
+	"@daily", "@every 90m", "@reboot", "H(0-29)/10 H * * *", "0~30 R * * *", "0 0 29 2 *", "0 0 31 2 *",
+	"", " ", "* * *", "1,,2 * * * *", "-1 * * * *", "1-2-3/4/5 * * * *", "0 0 * * * * *", "L-#W ? ? ? ?",
+}
+
+func FuzzSegments(f *testing.F) {
+	for _, seed := range fuzzSeeds {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, expr string) {
+		segs, err := Segments(expr)
+		if err == nil && len(segs) != 5 && len(segs) != 6 {
+			t.Errorf("expected 5 or 6 segments, got %v", segs)
+		}
+		_ = Validate(expr)
+		_ = Lint(expr)
+	})
+}
+
+func FuzzIsDue(f *testing.F) {
+	for _, seed := range fuzzSeeds {
+		f.Add(seed, int64(1618826400))
+	}
+
+	gron := New(WithHashKey("fuzz"), WithSeed(1))
+	f.Fuzz(func(t *testing.T, expr string, unix int64) {
+		ref := time.Unix(unix%(1<<34), 0).UTC()
+		due, err := gron.IsDue(expr, ref)
+		if due && err != nil {
+			t.Errorf("expected not due with error, got %v", err)
+		}
+		_ = gron.IsValid(expr)
+	})
+}
+
+func FuzzGetPrev(f *testing.F) {
+	for _, seed := range fuzzSeeds {
+		f.Add(seed)
+	}
+
+	gron := New(WithHashKey("fuzz"), WithSeed(1), WithSearchYears(5))
+	ref := time.Date(2021, time.April, 19, 10, 30, 0, 0, time.UTC)
+	f.Fuzz(func(t *testing.T, expr string) {
+		prev, err := gron.GetPrev(expr, ref)
+		if err == nil && prev.After(ref) {
+			t.Errorf("expected previous due on or before %v, got %v", ref, prev)
+		}
+	})
+}
+
+func TestLimits(t *testing.T) {
+	tests := map[string]string{
+		strings.Repeat("1,", MaxListElements) + "1 * * * *":  "list elements",
+		"* * * * " + strings.Repeat("*", MaxExprLength):      "length",
+		"@every " + strings.Repeat("1", MaxExprLength) + "s": "length",
+	}
+
+	for expr, limit := range tests {
+		t.Run("limit "+limit, func(t *testing.T) {
+			var lerr *LimitError
+			if err := Validate(expr); !errors.As(err, &lerr) || lerr.Limit != limit || !errors.Is(err, ErrLimit) {
+				t.Errorf("expected %s LimitError, got %v", limit, err)
+			}
+			gron := New()
+			if _, err := gron.IsDue(expr); !errors.Is(err, ErrLimit) {
+				t.Errorf("expected ErrLimit, got %v", err)
+			}
+		})
+	}
+
+	t.Run("limit within", func(t *testing.T) {
+		if err := Validate(strings.Repeat("1,", MaxListElements-1) + "1 * * * *"); err != nil {
+			t.Errorf("expected no error, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,79 @@
+package gronx
+
+import (
+	"container/heap"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Gap is the interval from Start to End that none of cron exprs is due in between, lasting
+// Duration of elapsed time.
+type Gap struct {
+	Start    time.Time
+	End      time.Time
+	Duration time.Duration
+}
+
+// Gaps gets the intervals between start and end longer than maxGap that none of cron exprs is
+// due in, like Gronx.Gaps. It returns error if any of exprs is invalid.
+func Gaps(exprs []string, start, end time.Time, maxGap time.Duration) ([]Gap, error) {
+	for _, expr := range exprs {
+		if err := Validate(expr); err != nil {
+			return nil, err
+		}
+	}
+
+	gron := New()
+
+	return gron.Gaps(exprs, start, end, maxGap)
+}
+
+// Gaps gets the intervals between start and end (both inclusive) longer than maxGap that none of
+// cron exprs is due in, in ascending order. Each gap is from one due time to the next of any of
+// exprs, or from start to the first one and from the last one to end, where the due times of
+// exprs are merged as they are found like Collisions. The durations are of elapsed time, so a
+// gap across DST transition is longer or shorter than the wall clock says, and the instants are
+// given in the location of start. No exprs leave the whole window as a gap. If there are more
+// than MaxOccurrences gaps, it returns those collected so far along with error. It returns error
+// if any of exprs is invalid or end is before start.
+func (g *Gronx) Gaps(exprs []string, start, end time.Time, maxGap time.Duration) ([]Gap, error) {
+	if end.Before(start) {
+		return nil, errors.New("end should not be before start")
+	}
+
+	cursors, err := g.dueCursors(exprs, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	gaps, from, loc := []Gap{}, start, start.Location()
+	for {
+		to := end
+		if len(cursors) > 0 {
+			to = cursors[0].at
+		}
+
+		if to.Sub(from) > maxGap {
+			if len(gaps) == MaxOccurrences {
+				return gaps, fmt.Errorf("more than %d gaps between %s and %s", MaxOccurrences, start, end)
+			}
+			gaps = append(gaps, Gap{Start: from.In(loc), End: to.In(loc), Duration: to.Sub(from)})
+		}
+		if len(cursors) == 0 {
+			return gaps, nil
+		}
+
+		from = to
+		for len(cursors) > 0 && cursors[0].at.Equal(to) {
+			c := heap.Pop(&cursors).(*dueCursor)
+			ok, err := c.advance(c.at.Add(c.e.span()))
+			if err != nil {
+				return gaps, err
+			}
+			if ok {
+				heap.Push(&cursors, c)
+			}
+		}
+	}
+}
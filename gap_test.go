@@ -0,0 +1,165 @@
+package gronx
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestGaps(t *testing.T) {
+	start := time.Date(2026, time.October, 14, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		exprs  []string
+		start  time.Time
+		end    time.Time
+		maxGap time.Duration
+		expect []string
+	}{
+		{
+			[]string{"*/20 * * * *", "*/30 * * * *"}, start, start.Add(2 * time.Hour), 15 * time.Minute,
+			[]string{"00:00 00:20 20m0s", "00:40 01:00 20m0s", "01:00 01:20 20m0s", "01:40 02:00 20m0s"},
+		},
+		{
+			[]string{"*/20 * * * *", "*/30 * * * *"}, start, start.Add(2 * time.Hour), 20 * time.Minute,
+			nil,
+		},
+		{
+			[]string{"0 9-17 * * *", "30 12 * * *"}, start, start.AddDate(0, 0, 1), time.Hour,
+			[]string{"00:00 09:00 9h0m0s", "17:00 00:00 7h0m0s"},
+		},
+		{
+			[]string{"0 * * * *"}, start.Add(10 * time.Minute), start.Add(130 * time.Minute), 55 * time.Minute,
+			[]string{"01:00 02:00 1h0m0s"},
+		},
+		{
+			[]string{"0 * * * *"}, start.Add(10 * time.Minute), start.Add(130 * time.Minute), 45 * time.Minute,
+			[]string{"00:10 01:00 50m0s", "01:00 02:00 1h0m0s"},
+		},
+		{
+			[]string{"CRON_TZ=Asia/Kolkata 30 5 * * *", "0 12 * * *"}, start, start.AddDate(0, 0, 1), 11 * time.Hour,
+			[]string{"00:00 12:00 12h0m0s", "12:00 00:00 12h0m0s"},
+		},
+		{
+			nil, start, start.Add(time.Hour), 0,
+			[]string{"00:00 01:00 1h0m0s"},
+		},
+		{
+			[]string{}, start, start.Add(time.Hour), time.Hour,
+			nil,
+		},
+		{
+			[]string{"CRON_TZ=America/New_York 0 0-23 * * *"}, time.Date(2026, time.November, 1, 3, 0, 0, 0, time.UTC),
+			time.Date(2026, time.November, 1, 9, 0, 0, 0, time.UTC), time.Hour,
+			[]string{"05:00 07:00 2h0m0s"},
+		},
+		{
+			[]string{"CRON_TZ=America/New_York 0 0-23 * * *"}, time.Date(2026, time.March, 8, 4, 0, 0, 0, time.UTC),
+			time.Date(2026, time.March, 8, 10, 0, 0, 0, time.UTC), time.Hour,
+			nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run("gaps "+strings.Join(test.exprs, " | "), func(t *testing.T) {
+			gaps, err := Gaps(test.exprs, test.start, test.end, test.maxGap)
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			actual := []string{}
+			for _, gap := range gaps {
+				if gap.Duration != gap.End.Sub(gap.Start) {
+					t.Errorf("expected %v, got %v", gap.End.Sub(gap.Start), gap.Duration)
+				}
+				actual = append(actual, fmt.Sprintf("%s %s %v", gap.Start.Format("15:04"), gap.End.Format("15:04"), gap.Duration))
+			}
+			if fmt.Sprint(actual) != fmt.Sprint(test.expect) {
+				t.Errorf("expected %v, got %v", test.expect, actual)
+			}
+		})
+	}
+
+	t.Run("gaps seconds", func(t *testing.T) {
+		gron := New(WithSecondsField())
+		gaps, err := gron.Gaps([]string{"*/10 * * * * *"}, start.Add(5*time.Second), start.Add(40*time.Second), 5*time.Second)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		actual := []string{}
+		for _, gap := range gaps {
+			actual = append(actual, fmt.Sprintf("%s %s %v", gap.Start.Format("04:05"), gap.End.Format("04:05"), gap.Duration))
+		}
+		if expect := "[00:10 00:20 10s 00:20 00:30 10s 00:30 00:40 10s]"; fmt.Sprint(actual) != expect {
+			t.Errorf("expected %v, got %v", expect, actual)
+		}
+	})
+
+	t.Run("gaps invalid", func(t *testing.T) {
+		if _, err := Gaps([]string{"* * * * *", "* * * *"}, start, start.Add(time.Hour), time.Minute); err == nil {
+			t.Error("expected error")
+		}
+		if _, err := Gaps([]string{"* * * * *"}, start, start.Add(-time.Hour), time.Minute); err == nil {
+			t.Error("expected error")
+		}
+	})
+}
+
+func TestGapsDue(t *testing.T) {
+	rnd := rand.New(rand.NewSource(99))
+	zones := []string{"", "CRON_TZ=Asia/Kolkata ", "CRON_TZ=America/New_York "}
+	segments := [][]string{
+		{"0", "*/15", "0,30", "7", "*/10"},
+		{"*", "*/2", "9-17", "0", "1,2,3"},
+		{"*", "8", "1-7"},
+		{"*"},
+		{"*", "1-5", "0,6"},
+	}
+	gron := New()
+	start := time.Date(2026, time.October, 31, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 0, 3)
+
+	for i := 0; i < 20; i++ {
+		exprs := make([]string, 1+rnd.Intn(3))
+		for j := range exprs {
+			fields := make([]string, len(segments))
+			for pos, values := range segments {
+				fields[pos] = values[rnd.Intn(len(values))]
+			}
+			exprs[j] = zones[rnd.Intn(len(zones))] + strings.Join(fields, " ")
+		}
+		maxGap := time.Duration(rnd.Intn(180)) * time.Minute
+
+		t.Run("gaps due "+strings.Join(exprs, " | "), func(t *testing.T) {
+			gaps, err := gron.Gaps(exprs, start, end, maxGap)
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			expect, from := []string{}, start
+			for ref := start; !ref.After(end); ref = ref.Add(time.Minute) {
+				due := ref.Equal(end)
+				for _, expr := range exprs {
+					ok, _ := gron.IsDue(expr, ref)
+					due = due || ok
+				}
+				if due {
+					if ref.Sub(from) > maxGap {
+						expect = append(expect, from.Format(dateFormat)+" "+ref.Format(dateFormat))
+					}
+					from = ref
+				}
+			}
+
+			actual := []string{}
+			for _, gap := range gaps {
+				actual = append(actual, gap.Start.Format(dateFormat)+" "+gap.End.Format(dateFormat))
+			}
+			if fmt.Sprint(actual) != fmt.Sprint(expect) {
+				t.Errorf("expected %v, got %v", expect, actual)
+			}
+		})
+	}
+}
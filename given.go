@@ -0,0 +1,129 @@
+package gronx
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// Segment is a cron part as checked (eg: 5) along with the text Given for it in expr (eg: fri),
+// which is empty for the parts not given like year of 5 segments.
+type Segment struct {
+	Value string
+	Given string
+}
+
+// ParseSegments splits expr into cron parts like Segments, each along with its text as given in
+// expr before names are replaced, so that it can be shown as written. It returns slice or error
+// if any, which quotes the segment as given.
+func ParseSegments(expr string) ([]Segment, error) {
+	segs, err := Segments(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	return withGivenSegments(segs, givenSegments(expr, false)), nil
+}
+
+// ParseSegments splits expr into cron parts as per the layout of Gronx like Segments, each along
+// with its text as given in expr. It returns slice or error if any.
+func (g *Gronx) ParseSegments(expr string) ([]Segment, error) {
+	segs, err := g.segments(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	return withGivenSegments(segs, givenSegments(expr, g.seconds)), nil
+}
+
+// withGivenSegments pairs segs with their given text by position.
+func withGivenSegments(segs, given []string) []Segment {
+	out := make([]Segment, len(segs))
+	for pos, seg := range segs {
+		out[pos].Value = seg
+		if pos < len(given) {
+			out[pos].Given = given[pos]
+		}
+	}
+
+	return out
+}
+
+// givenSegments gives the text of each segment in expr as given, indexed by Pos constants, with
+// macros expanded and seconds (if first) moved to the end.
+func givenSegments(expr string, seconds bool) []string {
+	expr = strings.Trim(expr, " \t")
+	e, isMacro := macro(expr)
+	if isMacro {
+		expr = e
+	}
+
+	fields := SpaceRe.Split(expr, -1)
+	if !seconds || isMacro || len(fields) < 2 {
+		return fields
+	}
+
+	given := make([]string, PosSecond+1)
+	copy(given, fields[1:])
+	given[PosSecond] = fields[0]
+
+	return given
+}
+
+// withGiven sets the text as given on each FieldError of errs by its position.
+func withGiven(errs []error, given []string) []error {
+	for _, err := range errs {
+		var ferr *FieldError
+		if errors.As(err, &ferr) && ferr.Pos >= 0 && ferr.Pos < len(given) {
+			ferr.Given = given[ferr.Pos]
+		}
+	}
+
+	return errs
+}
+
+// givenValidation sets the text as given on err if it is FieldError, or on each of them if it is
+// ValidationErrors.
+func givenValidation(err error, given []string) error {
+	var verrs ValidationErrors
+	if errors.As(err, &verrs) {
+		withGiven(verrs, given)
+	} else if err != nil {
+		withGiven([]error{err}, given)
+	}
+
+	return err
+}
+
+// givenErr sets the text as given in expr on err if it is FieldError, for errors in checking due.
+func (g *Gronx) givenErr(expr string, err error) error {
+	if err != nil {
+		withGiven([]error{err}, givenSegments(expr, g.seconds))
+	}
+
+	return err
+}
+
+// givenText rewrites msg about segment to quote it as given instead, where the segment or its
+// list elements are quoted or end the message.
+func givenText(msg, segment, given string) string {
+	pairs := [][2]string{{segment, given}}
+	offsets, givens := strings.Split(segment, ","), strings.Split(given, ",")
+	if len(offsets) > 1 && len(offsets) == len(givens) {
+		for i, offset := range offsets {
+			if offset != givens[i] {
+				pairs = append(pairs, [2]string{offset, givens[i]})
+			}
+		}
+	}
+
+	for _, pair := range pairs {
+		msg = strings.ReplaceAll(msg, strconv.Quote(pair[0]), strconv.Quote(pair[1]))
+		if strings.HasSuffix(msg, ": "+pair[0]) {
+			msg = strings.TrimSuffix(msg, pair[0]) + pair[1]
+		}
+		msg = strings.ReplaceAll(msg, ": "+pair[0]+", ", ": "+pair[1]+", ")
+	}
+
+	return msg
+}
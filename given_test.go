@@ -0,0 +1,111 @@
+package gronx
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseSegments(t *testing.T) {
+	t.Run("parse segments given", func(t *testing.T) {
+		segs, err := ParseSegments("0  0 * jan-mar fri")
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		expect := []Segment{{"0", "0"}, {"0", "0"}, {"*", "*"}, {"1-3", "jan-mar"}, {"5", "fri"}}
+		if len(segs) != len(expect) {
+			t.Fatalf("expected %v, got %v", expect, segs)
+		}
+		for i, seg := range segs {
+			if seg != expect[i] {
+				t.Errorf("expected %v, got %v", expect[i], seg)
+			}
+		}
+	})
+
+	t.Run("parse segments seconds", func(t *testing.T) {
+		gron := New(WithSecondsField())
+		segs, err := gron.ParseSegments("30 0 0 * * sun")
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if segs[PosSecond] != (Segment{"30", "30"}) || segs[PosDayOfWeek] != (Segment{"0", "sun"}) || segs[PosYear] != (Segment{"*", ""}) {
+			t.Errorf("expected second 30, week day sun and no year given, got %v", segs)
+		}
+	})
+
+	t.Run("parse segments error", func(t *testing.T) {
+		if _, err := ParseSegments("0 0 * * FRI-MOM"); err == nil {
+			t.Errorf("expected error, got nil")
+		}
+	})
+}
+
+func TestGivenErrors(t *testing.T) {
+	tests := map[string]string{
+		"0 0 * * FRI-MOM":    `unknown name "MOM" in week day segment: FRI-MOM, did you mean MON?`,
+		"0 0 * jan-13 *":     "value 13 in month segment should be 1-12: jan-13",
+		"0 0 * 1,jan-13 *":   `invalid list element #1 "jan-13" in "1,jan-13": value 13 in month segment should be 1-12: jan-13`,
+		"0 0 * * MON,fri#6":  `invalid list element #1 "fri#6" in "MON,fri#6": nth of weekday should be 1-5: fri#6`,
+		"0 0 * JAN-DEC/0 *":  "step 0 in month segment should be 1-12: JAN-DEC/0",
+		"0 0 * * 1 2300":     "year should be 1970-2099: 2300",
+		"0 0 * * MON-FRU 9x": `unknown name "FRU" in week day segment: MON-FRU, did you mean FRI?`,
+	}
+
+	for expr, expect := range tests {
+		t.Run("given error "+expr, func(t *testing.T) {
+			err := Validate(expr)
+			if err == nil {
+				t.Fatalf("expected %s, got nil", expect)
+			}
+			var verrs ValidationErrors
+			if errors.As(err, &verrs) {
+				err = verrs[0]
+			}
+			if err.Error() != expect {
+				t.Errorf("expected %s, got %s", expect, err.Error())
+			}
+
+			var ferr *FieldError
+			if !errors.As(err, &ferr) || ferr.Given == "" {
+				t.Errorf("expected FieldError with given segment, got %v", err)
+			}
+		})
+	}
+
+	t.Run("given error is due", func(t *testing.T) {
+		gron := New(WithSecondsField())
+		_, err := gron.IsDue("0 0 0 * jan-13 *", time.Now())
+		if expect := "value 13 in month segment should be 1-12: jan-13"; err == nil || err.Error() != expect {
+			t.Errorf("expected %s, got %v", expect, err)
+		}
+	})
+
+	t.Run("given error segment", func(t *testing.T) {
+		_, err := Segments("0 0 * * sat-zun")
+		var ferr *FieldError
+		if !errors.As(err, &ferr) || ferr.Segment != "6-ZUN" || ferr.Given != "sat-zun" {
+			t.Errorf("expected normalized segment 6-ZUN given as sat-zun, got %+v", ferr)
+		}
+	})
+}
+
+func TestGivenText(t *testing.T) {
+	tests := []struct {
+		msg, segment, given, expect string
+	}{
+		{"bad: 5-7", "5-7", "fri-sun", "bad: fri-sun"},
+		{`bad "1" in "1,2": 1`, "1,2", "jan,feb", `bad "jan" in "jan,feb": jan`},
+		{"value 5 should be 0-7: 5", "5", "fri", "value 5 should be 0-7: fri"},
+		{"no segment here", "5", "fri", "no segment here"},
+	}
+
+	for _, test := range tests {
+		t.Run("given text "+test.msg, func(t *testing.T) {
+			if actual := givenText(test.msg, test.segment, test.given); actual != test.expect {
+				t.Errorf("expected %s, got %s", test.expect, actual)
+			}
+		})
+	}
+}
@@ -7,12 +7,6 @@ import (
 	"time"
 )
 
-var literals = strings.NewReplacer(
-	"SUN", "0", "MON", "1", "TUE", "2", "WED", "3", "THU", "4", "FRI", "5", "SAT", "6",
-	"JAN", "1", "FEB", "2", "MAR", "3", "APR", "4", "MAY", "5", "JUN", "6", "JUL", "7",
-	"AUG", "8", "SEP", "9", "OCT", "10", "NOV", "11", "DEC", "12",
-)
-
 var expressions = map[string]string{
 	"@yearly":    "0 0 1 1 *",
 	"@annually":  "0 0 1 1 *",
@@ -21,6 +15,11 @@ var expressions = map[string]string{
 	"@daily":     "0 0 * * *",
 	"@hourly":    "0 * * * *",
 	"@always":    "* * * * *",
+	"@minutely":  "* * * * *",
+	"@midnight":  "0 0 * * *",
+	"@weekday":   "0 0 * * 1-5",
+	"@weekend":   "0 0 * * 0,6",
+	"@quarterly": "0 0 1 */3 *",
 	"@5minutes":  "*/5 * * * *",
 	"@10minutes": "*/10 * * * *",
 	"@15minutes": "*/15 * * * *",
@@ -32,63 +31,325 @@ var SpaceRe = regexp.MustCompile(`\s+`)
 
 func normalize(expr string) []string {
 	expr = strings.Trim(expr, " \t")
-	if e, ok := expressions[strings.ToLower(expr)]; ok {
+	if e, ok := macro(expr); ok {
 		expr = e
 	}
 
-	expr = SpaceRe.ReplaceAllString(expr, " ")
-	expr = literals.Replace(strings.ToUpper(expr))
+	segs := strings.Fields(strings.ToUpper(expr))
+
+	// The names are only replaced in their own segment.
+	if len(segs) > 3 {
+		segs[3] = replaceNames(segs[3], months)
+	}
+	if len(segs) > 4 {
+		segs[4] = replaceNames(segs[4], days)
+	}
 
-	return strings.Split(strings.ReplaceAll(expr, "  ", " "), " ")
+	return segs
 }
 
+// The positions of segments, where second is last so that the others keep their positions
+// whether or not it is used.
+const (
+	PosMinute = iota
+	PosHour
+	PosDayOfMonth
+	PosMonth
+	PosDayOfWeek
+	PosYear
+	PosSecond
+)
+
 // Gronx is the main program.
 type Gronx struct {
-	C Checker
+	C              Checker
+	exclusive      bool
+	searchYears    int
+	years          yearRange
+	hashKey        string
+	seed           int64
+	anchor         time.Time
+	iso            bool
+	seconds        bool
+	quartz         bool
+	strict         bool
+	dayOr          bool
+	dialect        Dialect
+	loc            *time.Location
+	weekStart      time.Weekday
+	cache          *parseCache
+	workers        int
+	canonicalNames bool
 }
 
-// New initializes Gronx with factory defaults.
-func New() Gronx {
-	return Gronx{&SegmentChecker{}}
+// New initializes Gronx with factory defaults, overridden by given options if any.
+func New(opts ...Option) Gronx {
+	gron := Gronx{C: &SegmentChecker{}, seed: time.Now().UnixNano()}
+	for _, opt := range opts {
+		opt(&gron)
+	}
+
+	return gron
 }
 
 // IsDue checks if cron expression is due for given reference time (or now).
+// The seconds and nanoseconds of reference time are ignored, so 10:05:59.9 is due for
+// 5 10 * * *, as are nanoseconds if seconds field is enabled.
 // It returns bool or error if any.
 func (g *Gronx) IsDue(expr string, ref ...time.Time) (bool, error) {
+	t := time.Now()
 	if len(ref) > 0 {
-		g.C.SetRef(ref[0])
-	} else {
-		g.C.SetRef(time.Now())
+		t = ref[0]
+	}
+	if due, ok := g.fastDue(expr, t); ok {
+		return due, nil
 	}
 
-	segs, err := Segments(expr)
+	e, err := g.Parse(expr)
 	if err != nil {
 		return false, err
 	}
-
-	return g.SegmentsDue(segs)
+	return e.isDue(t, g.check(e))
 }
 
 // Segments splits expr into array array of cron parts.
-// It returns array or error.
+// It returns array or error, which is ErrReboot for @reboot.
 func Segments(expr string) ([]string, error) {
+	segs, errs := parseSegments(expr)
+	if len(errs) > 0 {
+		return []string{}, errs[0]
+	}
+
+	return segs, nil
+}
+
+// parseSegments splits expr into cron parts like Segments, along with the error of each invalid
+// segment. The parts are nil if expr as a whole is invalid, with its only error.
+func parseSegments(expr string) ([]string, []error) {
+	segs, errs := parseSegmentsIn(expr, defaultYears)
+	if len(errs) > 0 {
+		withGiven(errs, givenSegments(expr, false))
+	}
+
+	return segs, errs
+}
+
+// parseSegmentsIn splits expr into cron parts like parseSegments, with the years given in
+// year segment within years.
+func parseSegmentsIn(expr string, years yearRange) ([]string, []error) {
+	if err := limitErr(expr); err != nil {
+		return nil, []error{err}
+	}
+	if strings.EqualFold(strings.Trim(expr, " \t"), "@reboot") {
+		return nil, []error{ErrReboot}
+	}
+	if _, ok, _ := parseEvery(expr); ok {
+		return nil, []error{errors.New("@every duration can't be split into segments, only IsDue, GetNext and GetPrev support it")}
+	}
+
+	if err := macroErr(strings.Trim(expr, " \t")); err != nil {
+		return nil, []error{err}
+	}
+
 	segs := normalize(expr)
+	if len(segs) == 7 {
+		return nil, []error{errQuartzOnly}
+	}
 	if len(segs) < 5 || len(segs) > 6 {
-		return []string{}, errors.New("expr should contain 5-6 segments separated by space")
+		return nil, []error{kindErr(ErrSegmentCount, "expr should contain 5-6 segments separated by space")}
+	}
+
+	errs := []error{}
+	for pos, seg := range segs {
+		errs = append(errs, parseErrs(seg, pos, years)...)
+	}
+
+	return segs, errs
+}
+
+// parseErrs checks the names, steps and values within bounds of segment at given position,
+// with the years within years.
+func parseErrs(seg string, pos int, years yearRange) []error {
+	if err := unknownName(seg, pos); err != nil {
+		return []error{err}
+	}
+	if err := validSteps(seg, pos); err != nil {
+		return []error{err}
+	}
+	if pos == PosYear {
+		if _, _, _, err := yearSpan(seg, years); err != nil {
+			return []error{err}
+		}
+		return nil
+	}
+
+	return outOfRange(seg, pos)
+}
+
+// segments splits expr into cron parts like Segments, translating ISO week days if enabled,
+// resolving R and ~ with the seed and H with the hash key.
+func (g *Gronx) segments(expr string) ([]string, error) {
+	segs, errs := g.parse(expr)
+	if len(errs) > 0 {
+		return []string{}, errs[0]
 	}
 
 	return segs, nil
 }
 
-// SegmentsDue checks if all cron parts are due.
-// It returns bool. You should use IsDue(expr) instead.
-func (g *Gronx) SegmentsDue(segments []string) (bool, error) {
+// parse splits expr into cron parts like segments, along with the error of each invalid segment.
+// The parts are nil if expr as a whole is invalid, with its only error.
+func (g *Gronx) parse(expr string) (segs []string, errs []error) {
+	defer func(expr string) {
+		if len(errs) > 0 {
+			withGiven(errs, givenSegments(expr, g.seconds))
+		}
+	}(expr)
+
+	derrs := g.dialectErrs(expr)
+	if len(derrs) == 1 && errPosition(derrs[0]) < 0 {
+		return nil, derrs
+	}
+
+	iso := false
+	if g.iso {
+		// The week day field comes after seconds if enabled.
+		pos := PosDayOfWeek
+		if g.seconds {
+			pos++
+		}
+		expr, iso = isoExpr(expr, pos)
+	}
+
+	segs, errs = g.split(expr)
+	if segs == nil {
+		return nil, errs
+	}
+	if dow, ok := g.weeklyDay(expr); ok {
+		segs[PosDayOfWeek] = dow
+	}
+
+	errs = append(derrs, errs...)
+	invalid := errPositions(errs)
+	if iso && !invalid[PosDayOfWeek] {
+		dow, err := isoWeekday(segs[PosDayOfWeek])
+		if err != nil {
+			errs, invalid[PosDayOfWeek] = append(errs, err), true
+		}
+		segs[PosDayOfWeek] = dow
+	}
+	if _, ok := macro(strings.Trim(expr, " \t")); g.strict && !ok {
+		if err := strictDays(segs); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	for pos, seg := range segs {
+		if invalid[pos] {
+			continue
+		}
+
+		var err error
+		if strings.ContainsAny(seg, "~R") {
+			if seg, err = resolveRandom(seg, pos, g.seed); err != nil {
+				errs = append(errs, fieldErr(pos, segs[pos], ReasonRandom, err))
+				continue
+			}
+		}
+		if strings.Contains(seg, "H") {
+			if seg, err = resolveHash(seg, pos, g.hashKey); err != nil {
+				errs = append(errs, fieldErr(pos, segs[pos], ReasonHash, err))
+				continue
+			}
+		}
+		segs[pos] = seg
+	}
+
+	return segs, errs
+}
+
+// split splits expr into cron parts like parseSegments, with seconds moved to the end if enabled
+// and Quartz fields translated if enabled.
+func (g *Gronx) split(expr string) ([]string, []error) {
+	if !g.seconds {
+		return parseSegmentsIn(expr, g.yearRange())
+	}
+
+	if err := limitErr(expr); err != nil {
+		return nil, []error{err}
+	}
+	if err := macroErr(strings.Trim(expr, " \t")); err != nil {
+		return nil, []error{err}
+	}
+
+	second, fields := "0", SpaceRe.Split(strings.Trim(expr, " \t"), -1)
+	if _, ok := macro(strings.Trim(expr, " \t")); !ok {
+		if g.quartz && len(fields) != 6 && len(fields) != 7 {
+			return nil, []error{kindErr(ErrSegmentCount, "expr should contain 6 or 7 segments separated by space with seconds first")}
+		}
+		if !g.quartz && len(fields) == 7 {
+			return nil, []error{errQuartzOnly}
+		}
+		if !g.quartz && len(fields) != 6 {
+			return nil, []error{kindErr(ErrSegmentCount, "expr should contain 6 segments separated by space with seconds first")}
+		}
+
+		var err error
+		if g.quartz {
+			if fields, err = quartzFields(fields); err != nil {
+				return nil, []error{err}
+			}
+		}
+		second, expr = strings.ToUpper(fields[0]), strings.Join(fields[1:], " ")
+	}
+
+	segs, errs := parseSegmentsIn(expr, g.yearRange())
+	if segs == nil {
+		return nil, errs
+	}
+
+	if len(segs) == 5 {
+		segs = append(segs, "*")
+	}
+	segs = append(segs, second)
+	errs = append(errs, parseErrs(second, PosSecond, defaultYears)...)
+
+	return segs, errs
+}
+
+// Segments splits expr into cron parts as per the layout of Gronx, with R, ~ and H resolved.
+// The parts are indexed by Pos constants, so seconds (if enabled) come last.
+// It returns slice or error if any.
+func (g *Gronx) Segments(expr string) ([]string, error) {
+	return g.segments(expr)
+}
+
+// SegmentsDue checks if all cron parts are due for given reference time (or now), with either
+// of day of month and week day if WithDayOr applies. It returns bool. You should use
+// IsDue(expr) instead.
+func (g *Gronx) SegmentsDue(segments []string, ref ...time.Time) (bool, error) {
+	t := time.Now()
+	if len(ref) > 0 {
+		t = ref[0]
+	}
+
+	or := g.dayOr && dayRestricted(segments)
 	for pos, seg := range segments {
-		if seg == "*" || seg == "?" {
+		if seg == "*" || seg == "?" || (or && pos == PosDayOfWeek) {
+			continue
+		}
+		if or && pos == PosDayOfMonth {
+			due, err := g.C.CheckDue(seg, pos, t)
+			if !due && err == nil {
+				due, err = g.C.CheckDue(segments[PosDayOfWeek], PosDayOfWeek, t)
+			}
+			if !due {
+				return due, err
+			}
 			continue
 		}
 
-		if due, err := g.C.CheckDue(seg, pos); !due {
+		if due, err := g.C.CheckDue(seg, pos, t); !due {
 			return due, err
 		}
 	}
@@ -96,10 +357,8 @@ func (g *Gronx) SegmentsDue(segments []string) (bool, error) {
 	return true, nil
 }
 
-// IsValid checks if cron expression is valid.
-// It returns bool.
+// IsValid checks if cron expression is valid, including @reboot, without checking it for due.
+// It returns bool. Use Validate(expr) for the error.
 func (g *Gronx) IsValid(expr string) bool {
-	_, err := g.IsDue(expr)
-
-	return err == nil
+	return g.Validate(expr) == nil
 }
@@ -1,8 +1,11 @@
 package gronx
 
 import (
+	"errors"
+	"fmt"
 	"log"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 )
@@ -26,10 +29,14 @@ func (test Case) run(gron Gronx) (bool, error) {
 
 func TestNormalize(t *testing.T) {
 	tests := map[string]string{
-		"*   *  *\t*\n*":        "* * * * *",
-		"* * * * * 2021":        "* * * * * 2021",
-		"@hourly":               "0 * * * *",
-		"0 0 JAN,feb * sun,MON": "0 0 1,2 * 0,1",
+		"*   *  *\t*\n*":          "* * * * *",
+		"* * * * * 2021":          "* * * * * 2021",
+		"@hourly":                 "0 * * * *",
+		" @Quarterly\t":           "0 0 1 */3 *",
+		"@WEEKDAY ":               "0 0 * * 1-5",
+		"0 0 * JAN,feb sun,MON":   "0 0 * 1,2 0,1",
+		"0 0 * JAN-JUN/2 SAT-SUN": "0 0 * 1-6/2 6-7",
+		"0 0 * MON JAN":           "0 0 * MON JAN",
 	}
 
 	for expr, expect := range tests {
@@ -57,6 +64,41 @@ func TestIsValid(t *testing.T) {
 			t.Errorf("expected true, got false")
 		}
 	})
+
+	t.Run("is valid last day", func(t *testing.T) {
+		if !gron.IsValid("0 0 L * *") {
+			t.Errorf("expected true, got false")
+		}
+		if gron.IsValid("* * L/2 * *") {
+			t.Errorf("expected false, got true")
+		}
+	})
+
+	t.Run("is valid reboot", func(t *testing.T) {
+		if !gron.IsValid(" @REBOOT ") {
+			t.Errorf("expected true, got false")
+		}
+		if gron.IsValid("@reboot *") {
+			t.Errorf("expected false, got true")
+		}
+	})
+}
+
+func TestReboot(t *testing.T) {
+	gron := New()
+
+	if _, err := Segments("@reboot"); !errors.Is(err, ErrReboot) {
+		t.Errorf("expected ErrReboot, got %v", err)
+	}
+	if due, err := gron.IsDue("@reboot"); due || !errors.Is(err, ErrReboot) {
+		t.Errorf("expected false with ErrReboot, got %v, %v", due, err)
+	}
+	if _, err := gron.GetPrev("@reboot"); !errors.Is(err, ErrReboot) {
+		t.Errorf("expected ErrReboot, got %v", err)
+	}
+	if _, err := gron.GetNext("@reboot"); !errors.Is(err, ErrReboot) {
+		t.Errorf("expected ErrReboot, got %v", err)
+	}
 }
 
 func TestIsDue(t *testing.T) {
@@ -86,6 +128,245 @@ func TestIsDue(t *testing.T) {
 	}
 }
 
+func TestSubMinuteRef(t *testing.T) {
+	gron := New()
+
+	t.Run("sub minute monotonic", func(t *testing.T) {
+		now := time.Now()
+		expr, minute := fmt.Sprintf("%d %d * * *", now.Minute(), now.Hour()), truncMinute(now.Round(0))
+
+		for _, ref := range []time.Time{now, now.Round(0)} {
+			if due, err := gron.IsDue(expr, ref); err != nil || !due {
+				t.Errorf("expected due at %v, got %v, %v", ref, due, err)
+			}
+			prev, err := gron.GetPrev(expr, ref)
+			if err != nil || *prev != minute || strings.Contains(prev.String(), "m=") {
+				t.Errorf("expected %v without monotonic reading, got %v, %v", minute, prev, err)
+			}
+			next, err := gron.GetNext(expr, ref)
+			if err != nil || *next != minute {
+				t.Errorf("expected %v, got %v, %v", minute, next, err)
+			}
+			if tick, err := PrevTick(expr, ref); err != nil || tick != minute {
+				t.Errorf("expected stateless %v, got %v, %v", minute, tick, err)
+			}
+		}
+	})
+
+	t.Run("sub minute nanoseconds", func(t *testing.T) {
+		tests := map[string]string{
+			"2024-01-01 10:05:00.000000001": "2024-01-01 10:05:00",
+			"2024-01-01 10:05:59.999999999": "2024-01-01 10:05:00",
+			"2024-01-01 10:04:59.999999999": "2023-12-31 10:05:00",
+		}
+		for value, expect := range tests {
+			ref, err := time.Parse("2006-01-02 15:04:05.999999999", value)
+			abort(err)
+
+			if due, err := gron.IsDue("5 10 * * *", ref); err != nil || due != (ref.Minute() == 5) {
+				t.Errorf("%s: expected due %v, got %v, %v", value, ref.Minute() == 5, due, err)
+			}
+			if prev, err := gron.GetPrev("5 10 * * *", ref); err != nil || prev.Format(dateFormat) != expect {
+				t.Errorf("%s: expected %s, got %v, %v", value, expect, prev, err)
+			}
+		}
+	})
+
+	t.Run("sub minute seconds field", func(t *testing.T) {
+		gron := New(WithSecondsField())
+		ref := time.Date(2024, time.January, 1, 10, 5, 30, 700000000, time.UTC)
+
+		if due, err := gron.IsDue("30 5 10 * * *", ref); err != nil || !due {
+			t.Errorf("expected due, got %v, %v", due, err)
+		}
+		if prev, err := gron.GetPrev("30 5 10 * * *", ref); err != nil || !prev.Equal(ref.Truncate(time.Second)) {
+			t.Errorf("expected %v, got %v, %v", ref.Truncate(time.Second), prev, err)
+		}
+	})
+}
+
+func TestStepStart(t *testing.T) {
+	tests := []struct {
+		expr string
+		ref  string
+		due  bool
+	}{
+		{"*/20 * * * *", "2024-05-02 10:40:00", true},
+		{"0/20 * * * *", "2024-05-02 10:40:00", true},
+		{"*/20 * * * *", "2024-05-02 10:50:00", false},
+		{"0/20 * * * *", "2024-05-02 10:50:00", false},
+		{"0 */5 * * *", "2024-05-02 10:00:00", true},
+		{"0 0/5 * * *", "2024-05-02 10:00:00", true},
+		{"0 */5 * * *", "2024-05-02 11:00:00", false},
+		{"0 0/5 * * *", "2024-05-02 11:00:00", false},
+		{"0 0 */2 * *", "2024-05-03 00:00:00", true},
+		{"0 0 */2 * *", "2024-05-02 00:00:00", false},
+		{"0 0 1 */3 *", "2024-04-01 00:00:00", true},
+		{"0 0 1 */3 *", "2024-03-01 00:00:00", false},
+	}
+
+	for _, test := range tests {
+		t.Run("step start "+test.expr+" at "+test.ref, func(t *testing.T) {
+			ref, _ := time.Parse(dateFormat, test.ref)
+			if due, err := IsDue(test.expr, ref); err != nil || due != test.due {
+				t.Errorf("expected %v, got %v (%v)", test.due, due, err)
+			}
+		})
+	}
+
+	t.Run("step start 0 invalid", func(t *testing.T) {
+		for _, expr := range []string{"0 0 0/2 * *", "0 0 1 0/3 *"} {
+			if err := Validate(expr); !errors.Is(err, ErrOutOfRange) {
+				t.Errorf("expected %v for %s, got %v", ErrOutOfRange, expr, err)
+			}
+		}
+	})
+}
+
+// legacyChecker is a Checker of former interface that holds the reference time.
+type legacyChecker struct {
+	ref time.Time
+}
+
+func (c *legacyChecker) GetRef() time.Time    { return c.ref }
+func (c *legacyChecker) SetRef(ref time.Time) { c.ref = ref }
+func (c *legacyChecker) CheckDue(segment string, pos int) (bool, error) {
+	return checkDue(segment, pos, c.ref)
+}
+
+// TestConcurrentUse is meant to be run with -race as well.
+func TestConcurrentUse(t *testing.T) {
+	exprs := []string{"*/7 9-17 * * *", "0 0 L * *", "15 10 * * MON-FRI", "0 12 1W * ?", "30 4 * * 5#2"}
+	refs := make([]time.Time, 16)
+	for i := range refs {
+		refs[i] = time.Date(2024, time.Month(1+i%12), 1+i, 9+i%9, i*7%60, 0, 0, time.UTC)
+	}
+
+	fresh := New()
+	type want struct {
+		due  bool
+		prev time.Time
+	}
+	wants := map[string]want{}
+	for _, expr := range exprs {
+		for _, ref := range refs {
+			due, err := fresh.IsDue(expr, ref)
+			abort(err)
+			prev, err := fresh.GetPrev(expr, ref)
+			abort(err)
+			wants[expr+ref.String()] = want{due, *prev}
+		}
+	}
+
+	for name, gron := range map[string]Gronx{"factory": New(), "cached": New(WithCache(2)), "legacy": {C: FromRefChecker(&legacyChecker{})}} {
+		t.Run("concurrent use "+name, func(t *testing.T) {
+			var wg sync.WaitGroup
+			for i := 0; i < 16; i++ {
+				wg.Add(1)
+				go func(i int) {
+					defer wg.Done()
+					for j := 0; j < 40; j++ {
+						expr, ref := exprs[(i+j)%len(exprs)], refs[(i*j)%len(refs)]
+						expect := wants[expr+ref.String()]
+						if due, err := gron.IsDue(expr, ref); err != nil || due != expect.due {
+							t.Errorf("%s at %v: expected %v, got %v, %v", expr, ref, expect.due, due, err)
+						}
+						if prev, err := gron.GetPrev(expr, ref); err != nil || !prev.Equal(expect.prev) {
+							t.Errorf("%s at %v: expected %v, got %v, %v", expr, ref, expect.prev, prev, err)
+						}
+					}
+				}(i)
+			}
+			wg.Wait()
+		})
+	}
+}
+
+func TestSegmentsDue(t *testing.T) {
+	gron, ref := New(), time.Date(2024, time.June, 3, 9, 5, 0, 0, time.UTC)
+	for segs, expect := range map[string]bool{"5 9 * * *": true, "5 9 3 * 1": true, "6 9 * * *": false} {
+		if due, err := gron.SegmentsDue(strings.Split(segs, " "), ref); err != nil || due != expect {
+			t.Errorf("%s: expected %v, got %v, %v", segs, expect, due, err)
+		}
+	}
+}
+
+func TestValidSteps(t *testing.T) {
+	gron := New()
+	errs := map[string]string{
+		"*/0 * * * *":         "step 0 in minute segment should be 1-60",
+		"*/-5 * * * *":        "step -5 in minute segment should be 1-60",
+		"*/75 * * * *":        "step 75 in minute segment should be 1-60",
+		"0 1-5,*/25 * * *":    "step 25 in hour segment should be 1-24",
+		"0 0 */32 * *":        "step 32 in day of month segment should be 1-31",
+		"0 0 1 JAN-JUN/13 *":  "step 13 in month segment should be 1-12",
+		"0 0 * * */8":         "step 8 in week day segment should be 1-7",
+		"0 0 1 1 * 2021/0":    "step 0 in year segment should be positive",
+		"0 0 1 1 * 2021/-100": "step -100 in year segment should be positive",
+	}
+
+	for expr, expect := range errs {
+		t.Run("valid steps err "+expr, func(t *testing.T) {
+			if _, err := gron.IsDue(expr); err == nil || !strings.Contains(err.Error(), expect) {
+				t.Errorf("expected %s, got %v", expect, err)
+			}
+			if _, err := gron.GetNext(expr); err == nil || !strings.Contains(err.Error(), expect) {
+				t.Errorf("expected %s, got %v", expect, err)
+			}
+			if _, err := gron.GetPrev(expr); err == nil || !strings.Contains(err.Error(), expect) {
+				t.Errorf("expected %s, got %v", expect, err)
+			}
+		})
+	}
+
+	for _, expr := range []string{"*/60 * * * *", "0 */24 * * *", "0 0 */31 * *", "0 0 1 */12 *", "0 0 * * */7", "0 0 1 1 * */200"} {
+		t.Run("valid steps "+expr, func(t *testing.T) {
+			if !gron.IsValid(expr) {
+				t.Errorf("expected true, got false")
+			}
+		})
+	}
+}
+
+func TestOutOfRange(t *testing.T) {
+	gron := New()
+	errs := map[string]string{
+		"61 * * * *":       "value 61 in minute segment should be 0-59: 61",
+		"0 25 * * *":       "value 25 in hour segment should be 0-23: 25",
+		"0 0 0 * *":        "value 0 in day of month segment should be 1-31: 0",
+		"0 0 * 13 *":       "value 13 in month segment should be 1-12: 13",
+		"0 0 * * 8":        "value 8 in week day segment should be 0-7: 8",
+		"5-70/5 * * * *":   "value 70 in minute segment should be 0-59: 5-70/5",
+		"1,99 * * * *":     `invalid list element #1 "99" in "1,99": value 99`,
+		"70/5 * * * *":     "value 70 in minute segment should be 0-59: 70/5",
+		"0 0 1 1 * 1969":   "year should be 1970-2099",
+		"0 0 1 0-12/2 * *": "value 0 in month segment should be 1-12",
+	}
+
+	for expr, expect := range errs {
+		t.Run("out of range err "+expr, func(t *testing.T) {
+			_, err := gron.IsDue(expr)
+			if err == nil || !strings.Contains(err.Error(), expect) {
+				t.Errorf("expected %s, got %v", expect, err)
+			}
+			if !errors.Is(err, ErrOutOfRange) {
+				t.Errorf("expected ErrOutOfRange, got %v", err)
+			}
+			if _, err := Segments(expr); err == nil {
+				t.Errorf("expected error, got nil")
+			}
+		})
+	}
+
+	for _, expr := range []string{"59 23 31 12 7", "0-59 0-23 1-31 1-12 0-7", "0 0 * * 5-7", "0 0 1 1 * 2099"} {
+		t.Run("out of range "+expr, func(t *testing.T) {
+			if _, err := gron.IsDue(expr); err != nil {
+				t.Errorf("expected no error, got %v", err)
+			}
+		})
+	}
+}
+
 func TestValueByPos(t *testing.T) {
 	t.Run("valueByPos 7", func(t *testing.T) {
 		if actual := valueByPos(time.Now(), 7); actual != 0 {
@@ -94,12 +375,155 @@ func TestValueByPos(t *testing.T) {
 	})
 }
 
+func TestListElements(t *testing.T) {
+	tests := []struct {
+		expr   string
+		pos    int
+		from   int
+		to     int
+		expect []int
+	}{
+		{"0-20/5,30,45-55/10 * * * *", 0, 0, 59, []int{0, 5, 10, 15, 20, 30, 45, 55}},
+		{"* */6,7,9-11,22-23/1,7 * * *", 1, 0, 23, []int{0, 6, 7, 9, 10, 11, 12, 18, 22, 23}},
+		{"* * 1-10/3,15,28-31,15 * *", 2, 1, 31, []int{1, 4, 7, 10, 15, 28, 29, 30, 31}},
+		{"* * * JAN-MAR,JUN,*/5 *", 3, 1, 12, []int{1, 2, 3, 6, 11}},
+		{"* * * * MON-WED,FRI,0", 4, 0, 6, []int{0, 1, 2, 3, 5}},
+		{"* * * * sun,WED-FRI/2,sun", 4, 0, 6, []int{0, 3, 5}},
+		{"* * * * * 2021,2023-2025,2030-2040/5", 5, 2020, 2041, []int{2021, 2023, 2024, 2025, 2030, 2035, 2040}},
+	}
+
+	for _, test := range tests {
+		expect := map[int]bool{}
+		for _, val := range test.expect {
+			expect[val] = true
+		}
+
+		for val := test.from; val <= test.to; val++ {
+			ref := refByPos(test.pos, val)
+			t.Run("list "+test.expr+" "+ref.Format(dateFormat), func(t *testing.T) {
+				actual, err := IsDue(test.expr, ref)
+				if err != nil || actual != expect[val] {
+					t.Errorf("expected %v, got %v, %v", expect[val], actual, err)
+				}
+			})
+		}
+	}
+
+	errs := map[string]string{
+		"1,A,3 * * * *":      `#1 "A"`,
+		"1,2,3-Z * * * *":    `#2 "3-Z"`,
+		"* * * * 1,,2":       `#1 ""`,
+		"* * 1,15,3/X * *":   `#2 "3/X"`,
+		"* * * * * 2020,X/2": `#1 "X/2"`,
+	}
+	for expr, expect := range errs {
+		t.Run("list err "+expr, func(t *testing.T) {
+			ref, _ := time.Parse(dateFormat, "2021-04-22 00:00:00")
+			_, err := IsDue(expr, ref)
+			if err == nil || !strings.Contains(err.Error(), expect) {
+				t.Errorf("expected %s, got %v", expect, err)
+			}
+		})
+	}
+}
+
+// refByPos gives the time in January 2021 (or given year) whose segment at pos is val.
+func refByPos(pos, val int) time.Time {
+	switch pos {
+	case 0:
+		return time.Date(2021, time.January, 1, 0, val, 0, 0, time.UTC)
+	case 1:
+		return time.Date(2021, time.January, 1, val, 0, 0, 0, time.UTC)
+	case 2:
+		return time.Date(2021, time.January, val, 0, 0, 0, 0, time.UTC)
+	case 3:
+		return time.Date(2021, time.Month(val), 1, 0, 0, 0, 0, time.UTC)
+	case 4:
+		return time.Date(2021, time.January, 3+val, 0, 0, 0, 0, time.UTC)
+	}
+
+	return time.Date(val, time.January, 1, 0, 0, 0, 0, time.UTC)
+}
+
 func testcases() []Case {
 	return []Case{
 		{"@always", "2021-04-19 12:54:09", true},
 		{"* * * * * 2018", "", false},
 		{"* * * * * 2018", "2021-04-19 12:54:09", false},
 		{"@5minutes", "2017-05-10 02:30:00", true},
+		{"@minutely", "2017-05-10 02:31:00", true},
+		{"@midnight", "2017-05-10 00:00:00", true},
+		{"@midnight", "2017-05-10 00:01:00", false},
+		{"@weekday", "2021-04-19 00:00:00", true},
+		{"@weekday", "2021-04-18 00:00:00", false},
+		{"@weekend", "2021-04-18 00:00:00", true},
+		{"@weekend", "2021-04-17 00:00:00", true},
+		{"@weekend", "2021-04-16 00:00:00", false},
+		{"@quarterly", "2021-04-01 00:00:00", true},
+		{"@quarterly", "2021-03-01 00:00:00", false},
+		{"0 0 * * 7", "2021-04-18 00:00:00", true},
+		{"0 0 * * 7", "2021-04-19 00:00:00", false},
+		{"0 0 * * 5-7", "2021-04-18 00:00:00", true},
+		{"0 0 * * 5-7", "2021-04-16 00:00:00", true},
+		{"0 0 * * 5-7", "2021-04-15 00:00:00", false},
+		{"0 0 * * 0,7", "2021-04-18 00:00:00", true},
+		{"0 0 * * 0,7", "2021-04-17 00:00:00", false},
+		{"0 0 * * 1-7/2", "2021-04-18 00:00:00", true},
+		{"0 0 * * SAT,7", "2021-04-18 00:00:00", true},
+		{"0 9 * * MON-FRI", "2021-04-16 09:00:00", true},
+		{"0 9 * * MON-FRI", "2021-04-17 09:00:00", false},
+		{"0 9 * * MON-FRI/2", "2021-04-21 09:00:00", true},
+		{"0 9 * * MON-FRI/2", "2021-04-20 09:00:00", false},
+		{"0 9 * * MON,WED-FRI", "2021-04-19 09:00:00", true},
+		{"0 9 * * MON,WED-FRI", "2021-04-20 09:00:00", false},
+		{"0 9 * * MON,WED-FRI", "2021-04-22 09:00:00", true},
+		{"0 0 1 * SAT-SUN", "2021-08-01 00:00:00", true},
+		{"0 0 1 * SAT-SUN", "2021-05-01 00:00:00", true},
+		{"0 0 1 * SAT-SUN", "2021-06-01 00:00:00", false},
+		{"0 0 1 JAN-JUN/2 *", "2021-03-01 00:00:00", true},
+		{"0 0 1 JAN-JUN/2 *", "2021-04-01 00:00:00", false},
+		{"0 0 1 jan-jun/2 *", "2021-05-01 00:00:00", true},
+		{"0 0 1 JAN,MAR-APR *", "2021-04-01 00:00:00", true},
+		{"5/10 * * * *", "2021-04-19 10:55:00", true},
+		{"5/10 * * * *", "2021-04-19 10:05:00", true},
+		{"5/10 * * * *", "2021-04-19 10:10:00", false},
+		{"0 3/8 * * *", "2021-04-19 19:00:00", true},
+		{"0 0 10/7 * *", "2021-05-30 00:00:00", false},
+		{"0 0 10/7 * *", "2021-05-31 00:00:00", true},
+		{"0 0 1 2/5 *", "2021-12-01 00:00:00", true},
+		{"0 0 * * 1/2", "2021-04-23 00:00:00", true},
+		{"0 0 * * 1/2", "2021-04-18 00:00:00", false},
+		{"0 0 1 1 * 2021/2", "2023-01-01 00:00:00", true},
+		{"0 22-2 * * *", "2021-04-19 23:00:00", true},
+		{"0 22-2 * * *", "2021-04-19 01:00:00", true},
+		{"0 22-2 * * *", "2021-04-19 00:00:00", true},
+		{"0 22-2 * * *", "2021-04-19 03:00:00", false},
+		{"0 22-2 * * *", "2021-04-19 21:00:00", false},
+		{"0 22-2/2 * * *", "2021-04-19 00:00:00", true},
+		{"0 22-2/2 * * *", "2021-04-19 02:00:00", true},
+		{"0 22-2/2 * * *", "2021-04-19 23:00:00", false},
+		{"0 22-2/2 * * *", "2021-04-19 01:00:00", false},
+		{"0 5-5 * * *", "2021-04-19 05:00:00", true},
+		{"0 5-5 * * *", "2021-04-19 06:00:00", false},
+		{"0-59 * * * *", "2021-04-19 05:37:00", true},
+		{"50-10 * * * *", "2021-04-19 05:05:00", true},
+		{"50-10 * * * *", "2021-04-19 05:30:00", false},
+		{"0 9 * * FRI-MON", "2021-04-18 09:00:00", true},
+		{"0 9 * * FRI-MON", "2021-04-19 09:00:00", true},
+		{"0 9 * * FRI-MON", "2021-04-17 09:00:00", true},
+		{"0 9 * * FRI-MON", "2021-04-20 09:00:00", false},
+		{"0 9 * * 6-0", "2021-04-18 09:00:00", true},
+		{"0 0 28-3 * *", "2021-04-30 00:00:00", true},
+		{"0 0 28-3 * *", "2021-04-02 00:00:00", true},
+		{"0 0 28-3 * *", "2021-04-15 00:00:00", false},
+		{"0 0 1 NOV-FEB *", "2021-01-01 00:00:00", true},
+		{"0 0 1 NOV-FEB *", "2021-03-01 00:00:00", false},
+		{"0 0 * * MONDAY", "2021-04-19 00:00:00", true},
+		{"0 0 * * monday-friday", "2021-04-18 00:00:00", false},
+		{"0 0 1 JANUARY-MAR *", "2021-02-01 00:00:00", true},
+		{"0 0 * DECEMBER SUNDAY", "2021-12-05 00:00:00", true},
+		{"0 0 */2 * *", "2021-04-01 00:00:00", true},
+		{"0 0 */2 * *", "2021-04-02 00:00:00", false},
 		{"* * 7W * *", "2017-10-15 20:00:00", false},
 		{"*/2 */2 * * *", "2015-08-10 21:47:27", false},
 		{"* * * * *", "2015-08-10 21:50:37", true},
@@ -138,6 +562,12 @@ func testcases() []Case {
 		{"0 0 1 JAN 0", "2011-06-15 23:09:00", false},
 		{"0 0 1 * 0", "2011-06-15 23:09:00", false},
 		{"0 0 L * *", "2011-07-15 00:00:00", false},
+		{"0 0 L * *", "2011-07-31 00:00:00", true},
+		{"0 0 L * *", "2011-04-30 00:00:00", true},
+		{"0 0 L * *", "2023-02-28 00:00:00", true},
+		{"0 0 L * *", "2024-02-28 00:00:00", false},
+		{"0 0 L * *", "2024-02-29 00:00:00", true},
+		{"0 0 l * *", "2024-02-29 00:00:00", true},
 		{"0 0 2W * *", "2011-07-01 00:00:00", true},
 		{"0 0 1W * *", "2011-05-01 00:00:00", false},
 		{"0 0 1W * *", "2011-07-01 00:00:00", true},
@@ -146,6 +576,40 @@ func testcases() []Case {
 		{"0 0 28W * *", "2011-07-01 00:00:00", false},
 		{"0 0 30W * *", "2011-07-01 00:00:00", false},
 		{"0 0 31W * *", "2011-07-01 00:00:00", false},
+		{"0 0 15W * *", "2021-05-14 00:00:00", true},
+		{"0 0 15W * *", "2021-05-15 00:00:00", false},
+		{"0 0 15W * *", "2021-08-16 00:00:00", true},
+		{"0 0 15W * *", "2021-08-13 00:00:00", false},
+		{"0 0 15W * *", "2021-04-15 00:00:00", true},
+		{"0 0 1W * *", "2021-05-03 00:00:00", true},
+		{"0 0 1W * *", "2021-05-01 00:00:00", false},
+		{"0 0 1W * *", "2021-04-30 00:00:00", false},
+		{"0 0 31W * *", "2021-10-29 00:00:00", true},
+		{"0 0 31W * *", "2021-11-01 00:00:00", false},
+		{"0 17 LW * *", "2021-01-29 17:00:00", true},
+		{"0 17 LW * *", "2021-01-31 17:00:00", false},
+		{"0 17 LW * *", "2021-02-26 17:00:00", true},
+		{"0 17 LW * *", "2021-03-31 17:00:00", true},
+		{"0 17 LW * *", "2021-04-30 17:00:00", true},
+		{"0 17 LW * *", "2021-05-31 17:00:00", true},
+		{"0 17 LW * *", "2021-08-31 17:00:00", true},
+		{"0 17 LW * *", "2021-06-30 17:00:00", true},
+		{"0 17 LW * *", "2021-07-30 17:00:00", true},
+		{"0 17 LW * *", "2021-09-30 17:00:00", true},
+		{"0 17 lw * *", "2021-10-29 17:00:00", true},
+		{"0 17 LW * *", "2021-10-31 17:00:00", false},
+		{"0 17 LW * *", "2024-02-29 17:00:00", true},
+		{"0 17 LW * *", "2025-02-28 17:00:00", true},
+		{"0 17 LW * *", "2026-02-27 17:00:00", true},
+		{"0 0 L-3 * *", "2021-01-28 00:00:00", true},
+		{"0 0 L-3 * *", "2021-01-27 00:00:00", false},
+		{"0 0 L-3 * *", "2021-04-27 00:00:00", true},
+		{"0 0 L-3 * *", "2021-02-25 00:00:00", true},
+		{"0 0 L-3 * *", "2024-02-26 00:00:00", true},
+		{"0 0 L-3 * *", "2024-02-25 00:00:00", false},
+		{"0 0 L-0 * *", "2024-02-29 00:00:00", true},
+		{"0 0 L-30 * *", "2021-01-01 00:00:00", true},
+		{"0 0 1,L-1 * *", "2021-01-30 00:00:00", true},
 		{"* * * * * 2012", "2011-05-01 00:00:00", false},
 		{"* * * * 5L", "2011-07-01 00:00:00", false},
 		{"* * * * 6L", "2011-07-01 00:00:00", false},
@@ -153,9 +617,25 @@ func testcases() []Case {
 		{"* * * * 1L", "2011-07-24 00:00:00", false},
 		{"* * * * TUEL", "2011-07-24 00:00:00", false},
 		{"* * * 1 5L", "2011-12-25 00:00:00", false},
+		{"0 18 * * 5L", "2021-04-30 18:00:00", true},
+		{"0 18 * * 5L", "2021-04-23 18:00:00", false},
+		{"0 18 * * FRIL", "2021-04-30 18:00:00", true},
+		{"0 18 * * fril", "2021-04-23 18:00:00", false},
+		{"0 18 * * 5L", "2021-02-26 18:00:00", true},
+		{"* * * * 7L", "2011-07-31 00:00:00", true},
+		{"* * * * 0L", "2011-07-31 00:00:00", true},
+		{"* * * * SUNL", "2011-07-24 00:00:00", false},
 		{"* * * * 5#2", "2011-07-01 00:00:00", false},
 		{"* * * * 5#1", "2011-07-01 00:00:00", true},
 		{"* * * * 3#4", "2011-07-01 00:00:00", false},
+		{"0 9 * * MON#2", "2021-06-14 09:00:00", true},
+		{"0 9 * * 1#2", "2021-06-07 09:00:00", false},
+		{"0 9 * * 1#2", "2021-06-21 09:00:00", false},
+		{"0 9 * * 1#3", "2021-06-21 09:00:00", true},
+		{"0 9 * * 1#5", "2021-05-31 09:00:00", true},
+		{"0 9 * * 5#2", "2021-05-14 09:00:00", true},
+		{"0 9 * * 7#1", "2021-08-01 09:00:00", true},
+		{"0 9 * * sun#1", "2021-08-08 09:00:00", false},
 		{"5/0 * * * *", "2021-04-19 12:54:09", false},
 		{"5/20 * * * *", "2018-08-13 00:24:00", false},
 		{"5/20 * * * *", "2018-08-13 00:45:00", true},
@@ -182,17 +662,48 @@ func errcases() []Case {
 		{"/ * * * *", "2011-07-01 00:01:00", false},
 		{"Z/Z * * * *", "2011-07-01 00:01:00", false},
 		{"Z/0 * * * *", "2011-07-01 00:01:00", false},
+		{"5/0 * * * *", "2011-07-01 00:05:00", false},
 		{"Z-10 * * * *", "2011-07-01 00:01:00", false},
 		{"1-Z * * * *", "2011-07-01 00:01:00", false},
 		{"1-Z/2 * * * *", "2011-07-01 00:01:00", false},
 		{"Z-Z/2 * * * *", "2011-07-01 00:01:00", false},
 		{"* * W * *", "", false},
+		{"0 0 * * JAN", "2021-04-19 00:00:00", false},
+		{"0 0 1 1 * 2030-2020", "2025-01-01 00:00:00", false},
+		{"0 0 1 1 * 2100", "2025-01-01 00:00:00", false},
+		{"0 0 1 1 * 1969", "2025-01-01 00:00:00", false},
+		{"0 0 1 1 * 2021,2100-2110", "2021-01-01 00:00:00", false},
+		{"0 0 1 1 * 1960/5", "2021-01-01 00:00:00", false},
+		{"0 0 * * MON-JUN", "2021-04-19 00:00:00", false},
+		{"0 0 * MON *", "2021-04-19 00:00:00", false},
+		{"0 0 JAN * *", "2021-04-19 00:00:00", false},
 		{"* * ZW * *", "", false},
+		{"* * 1-5W * *", "", false},
+		{"* * 0W * *", "", false},
+		{"* * 32W * *", "", false},
+		{"* * 15W1 * *", "", false},
+		{"* * LW/2 * *", "", false},
+		{"* * L-31 * *", "", false},
+		{"* * L-Z * *", "", false},
+		{"* * L--1 * *", "", false},
+		{"* * * * LW", "", false},
+		{"* * * LW *", "", false},
 		{"* * * * 4W", "2011-07-01 00:00:00", false},
 		{"* * * 1L *", "2011-07-01 00:00:00", false},
+		{"* * L/2 * *", "2011-07-01 00:00:00", false},
+		{"* * L-L * *", "2011-07-01 00:00:00", false},
+		{"* * 1-L * *", "2011-07-01 00:00:00", false},
+		{"* L * * *", "2011-07-01 01:00:00", false},
 		{"* * * * ZL", "", false},
+		{"* * * * 8L", "", false},
+		{"* * * * 5L2", "", false},
 		{"* * * * Z#", "", false},
 		{"* * * * 1#Z", "", false},
+		{"* * * * 1#0", "", false},
+		{"* * * * 1#6", "", false},
+		{"* * * * 8#1", "", false},
+		{"* * * * 1-5#2", "", false},
+		{"* * * * 1#2#3", "", false},
 	}
 }
 
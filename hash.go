@@ -0,0 +1,95 @@
+package gronx
+
+import (
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"strconv"
+	"strings"
+)
+
+// bounds are the min and max values of segments by position, which H and ~ resolve within.
+// The year is unbounded here.
+var bounds = [][2]int{{0, 59}, {0, 23}, {1, 31}, {1, 12}, {0, 6}, {0, 0}, {0, 59}}
+
+// resolveHash replaces H, H(lo-hi) and their /n variants in segment with values derived from key.
+func resolveHash(segment string, pos int, key string) (string, error) {
+	if key == "" {
+		return "", errors.New("hash key is required for H in cron segment: " + segment)
+	}
+	if pos == PosYear {
+		return "", errors.New("H is not supported in year segment: " + segment)
+	}
+
+	hash, offsets := hashOf(key, pos), strings.Split(segment, ",")
+	for i, offset := range offsets {
+		if !strings.HasPrefix(offset, "H") {
+			continue
+		}
+
+		min, max, rest, err := hashRange(offset[1:], pos)
+		if err != nil {
+			return "", err
+		}
+		if rest == "" {
+			offsets[i] = strconv.Itoa(min + hash%(max-min+1))
+			continue
+		}
+
+		step, err := strconv.Atoi(strings.TrimPrefix(rest, "/"))
+		if err != nil || step < 1 || rest[0] != '/' {
+			return "", errors.New("invalid step for H in cron segment: " + segment)
+		}
+		if step > max-min+1 {
+			step = max - min + 1
+		}
+		offsets[i] = strconv.Itoa(min+hash%step) + "-" + strconv.Itoa(max) + rest
+	}
+
+	return strings.Join(offsets, ","), nil
+}
+
+// hashRange parses the optional (lo-hi) following H, validated against the bounds of segment
+// at given position. It returns the range and whatever follows it.
+func hashRange(offset string, pos int) (int, int, string, error) {
+	min, max := bounds[pos][0], bounds[pos][1]
+	if !strings.HasPrefix(offset, "(") {
+		if pos == 2 {
+			// Bare H in day of month stops at 28 so that it is due in every month.
+			max = 28
+		}
+		return min, max, offset, nil
+	}
+
+	end := strings.Index(offset, ")")
+	if end < 0 {
+		return 0, 0, "", errors.New("H range should be closed with ): H" + offset)
+	}
+
+	parts := strings.Split(offset[1:end], "-")
+	if len(parts) != 2 {
+		return 0, 0, "", errors.New("H range should be lo-hi: H" + offset)
+	}
+	lo, err1 := strconv.Atoi(parts[0])
+	hi, err2 := strconv.Atoi(parts[1])
+	if err1 != nil || err2 != nil {
+		return 0, 0, "", errors.New("H range should be lo-hi: H" + offset)
+	}
+	if lo > hi {
+		return 0, 0, "", errors.New("H range is inverted: H" + offset)
+	}
+	if lo < min || hi > max {
+		return 0, 0, "", fmt.Errorf("H range should be within %d-%d: H%s", min, max, offset)
+	}
+
+	return lo, hi, offset[end+1:], nil
+}
+
+// hashOf gives a stable non negative hash of key for segment at given position.
+func hashOf(key string, pos int) int {
+	h := fnv.New32a()
+	h.Write([]byte(key))
+	h.Write([]byte{byte(pos)})
+
+	return int(h.Sum32() & 0x7fffffff)
+}
@@ -0,0 +1,160 @@
+package gronx
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWithHashKey(t *testing.T) {
+	t.Run("hash stable within bounds", func(t *testing.T) {
+		for _, key := range []string{"job-a", "job-b", "job-c", "backup"} {
+			gron := New(WithHashKey(key))
+			segs, err := gron.segments("H H H H H")
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			again, _ := gron.segments("H H H H H")
+			if strings.Join(segs, " ") != strings.Join(again, " ") {
+				t.Errorf("expected %v, got %v", segs, again)
+			}
+			for pos, seg := range segs {
+				if val := mustAtoi(t, seg); val < bounds[pos][0] || val > bounds[pos][1] {
+					t.Errorf("expected %s within %v", seg, bounds[pos])
+				}
+			}
+		}
+	})
+
+	t.Run("hash spreads keys", func(t *testing.T) {
+		minutes := map[string]bool{}
+		for _, key := range []string{"job-a", "job-b", "job-c", "job-d", "job-e"} {
+			gron := New(WithHashKey(key))
+			segs, _ := gron.segments("H * * * *")
+			minutes[segs[0]] = true
+		}
+		if len(minutes) < 2 {
+			t.Errorf("expected different minutes, got %v", minutes)
+		}
+	})
+
+	t.Run("hash due", func(t *testing.T) {
+		gron := New(WithHashKey("job-a"))
+		segs, _ := gron.segments("H * * * *")
+		ref, _ := time.Parse(dateFormat, "2021-04-19 10:00:00")
+		ref = ref.Add(time.Duration(mustAtoi(t, segs[0])) * time.Minute)
+
+		if due, err := gron.IsDue("H * * * *", ref); err != nil || !due {
+			t.Errorf("expected true, got %v, %v", due, err)
+		}
+		if due, _ := gron.IsDue("H * * * *", ref.Add(time.Minute)); due {
+			t.Errorf("expected false, got true")
+		}
+		if next, err := gron.GetNext("H * * * *", ref.Add(time.Minute)); err != nil || !next.Equal(ref.Add(time.Hour)) {
+			t.Errorf("expected %v, got %v, %v", ref.Add(time.Hour), next, err)
+		}
+		if prev, err := gron.GetPrev("H * * * *", ref.Add(-time.Minute)); err != nil || !prev.Equal(ref.Add(-time.Hour)) {
+			t.Errorf("expected %v, got %v, %v", ref.Add(-time.Hour), prev, err)
+		}
+	})
+
+	t.Run("hash step", func(t *testing.T) {
+		gron := New(WithHashKey("job-a"))
+		segs, _ := gron.segments("H/15 * * * *")
+		phase := hashOf("job-a", 0) % 15
+		if expect := strconv.Itoa(phase) + "-59/15"; segs[0] != expect {
+			t.Errorf("expected %s, got %s", expect, segs[0])
+		}
+
+		ref, _ := time.Parse(dateFormat, "2021-04-19 10:00:00")
+		times, err := gron.GetNextN("H/15 * * * *", ref, 4)
+		if err != nil || len(times) != 4 {
+			t.Fatalf("expected 4 times, got %v, %v", times, err)
+		}
+		for i, next := range times {
+			if next.Minute() != phase+15*i {
+				t.Errorf("expected minute %d, got %v", phase+15*i, next)
+			}
+		}
+	})
+
+	t.Run("hash in list", func(t *testing.T) {
+		gron := New(WithHashKey("job-a"))
+		if segs, err := gron.segments("H,30 * * * *"); err != nil || !strings.HasSuffix(segs[0], ",30") {
+			t.Errorf("expected hashed minute with 30, got %v, %v", segs, err)
+		}
+	})
+
+	t.Run("hash range", func(t *testing.T) {
+		for _, key := range []string{"job-a", "job-b", "job-c", "job-d", "job-e"} {
+			gron := New(WithHashKey(key))
+			segs, err := gron.segments("H(0-29) H(8-18) H(1-31) * *")
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			for pos, bound := range [][2]int{{0, 29}, {8, 18}, {1, 31}} {
+				if val := mustAtoi(t, segs[pos]); val < bound[0] || val > bound[1] {
+					t.Errorf("expected %s within %v", segs[pos], bound)
+				}
+			}
+		}
+	})
+
+	t.Run("hash range step", func(t *testing.T) {
+		gron := New(WithHashKey("job-a"))
+		segs, _ := gron.segments("H(0-29)/10 * * * *")
+		if expect := strconv.Itoa(hashOf("job-a", 0)%10) + "-29/10"; segs[0] != expect {
+			t.Errorf("expected %s, got %s", expect, segs[0])
+		}
+
+		segs, _ = gron.segments("H(20-25)/10 * * * *")
+		if expect := strconv.Itoa(20+hashOf("job-a", 0)%6) + "-25/10"; segs[0] != expect {
+			t.Errorf("expected %s, got %s", expect, segs[0])
+		}
+	})
+
+	for _, expr := range []string{
+		"H/0 * * * *", "H/x * * * *", "* * * * * H", "H5 * * * *", "H(29-0) * * * *", "H(0-60) * * * *",
+		"* * H(0-31) * *", "H(0-29 * * * *", "H(0) * * * *", "H(a-b) * * * *", "H(0-29)10 * * * *",
+	} {
+		t.Run("hash err "+expr, func(t *testing.T) {
+			gron := New(WithHashKey("job-a"))
+			if gron.IsValid(expr) {
+				t.Errorf("expected false, got true")
+			}
+		})
+	}
+
+	t.Run("hash range err message", func(t *testing.T) {
+		gron := New(WithHashKey("job-a"))
+		if _, err := gron.GetNext("H(29-0) * * * *"); err == nil || !strings.Contains(err.Error(), "inverted") {
+			t.Errorf("expected inverted error, got %v", err)
+		}
+		if _, err := gron.GetNext("* H(0-24) * * *"); err == nil || !strings.Contains(err.Error(), "within 0-23") {
+			t.Errorf("expected bounds error, got %v", err)
+		}
+	})
+
+	t.Run("hash without key", func(t *testing.T) {
+		gron := New()
+		if gron.IsValid("H * * * *") {
+			t.Errorf("expected false, got true")
+		}
+		if _, err := gron.GetNext("H * * * *"); err == nil || !strings.Contains(err.Error(), "hash key") {
+			t.Errorf("expected hash key error, got %v", err)
+		}
+	})
+}
+
+func mustAtoi(t *testing.T, s string) int {
+	t.Helper()
+	val, err := strconv.Atoi(s)
+	if err != nil {
+		t.Fatalf("expected number, got %s", s)
+	}
+
+	return val
+}
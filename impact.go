@@ -0,0 +1,148 @@
+package gronx
+
+import (
+	"sort"
+	"time"
+)
+
+// The effects of DSTEvent on the scheduled wall clock time.
+const (
+	// DSTSkipped is for the wall clock time that is not run at an instant of its own, ie in DST
+	// gap but the first which is shifted, or the second time in overlap if the hour is restricted.
+	DSTSkipped = "skipped"
+	// DSTShifted is for the first wall clock time in DST gap if the hour is restricted, which is
+	// run at the first instant after gap unless that is due by itself.
+	DSTShifted = "shifted"
+	// DSTDuplicated is for the wall clock time in DST overlap that is run both times.
+	DSTDuplicated = "duplicated"
+)
+
+// transitionScan is the interval to look for change of zone offset by, well within the
+// shortest time between DST transitions.
+const transitionScan = 6 * time.Hour
+
+// DSTEvent is the DST transition at Transition affecting the scheduled Wall clock time, with
+// the Effect (eg: DSTShifted) and the instants it Runs at. Wall has the fields of wall clock
+// in UTC, as it may not exist in the location.
+type DSTEvent struct {
+	Transition time.Time
+	Effect     string
+	Wall       time.Time
+	Runs       []time.Time
+}
+
+// DSTImpact gives the events of DST transitions in loc between from and to (inclusive) that
+// affect the due times of cron expr as per DST rules, sorted by transition then wall clock.
+// If loc is nil, it is the CRON_TZ= or TZ= prefix of expr if any, or the location of from.
+func DSTImpact(expr string, loc *time.Location, from, to time.Time) ([]DSTEvent, error) {
+	expr, zone, err := exprZone(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	segs, err := Segments(expr)
+	if err != nil {
+		return nil, err
+	}
+	if loc == nil {
+		loc = zone
+	}
+	if loc == nil {
+		loc = from.Location()
+	}
+
+	events := []DSTEvent{}
+	for _, at := range transitions(from.In(loc), to.In(loc)) {
+		evs, err := transitionEvents(segs, at)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, evs...)
+	}
+
+	return events, nil
+}
+
+// transitions finds the instants between from and to when zone offset changes, in order.
+func transitions(from, to time.Time) []time.Time {
+	found := []time.Time{}
+	for lo := from; !lo.After(to); lo = lo.Add(transitionScan) {
+		hi := lo.Add(transitionScan)
+		if hi.After(to) {
+			hi = to
+		}
+
+		_, loOff := lo.Zone()
+		if _, hiOff := hi.Zone(); loOff == hiOff {
+			continue
+		}
+
+		// Zone offsets change at whole seconds.
+		base := lo.Truncate(time.Second)
+		n := sort.Search(int(hi.Sub(base)/time.Second)+1, func(n int) bool {
+			_, off := base.Add(time.Duration(n) * time.Second).Zone()
+			return off != loOff
+		})
+		found = append(found, base.Add(time.Duration(n)*time.Second))
+	}
+
+	return found
+}
+
+// transitionEvents gives the events of DST transition at for the due wall clock times of segs
+// in its gap or overlap.
+func transitionEvents(segs []string, at time.Time) ([]DSTEvent, error) {
+	_, before := at.Add(-time.Second).Zone()
+	_, after := at.Zone()
+	shift, step := time.Duration(after-before)*time.Second, tick(segs)
+
+	forward := shift > 0
+	if !forward {
+		shift = -shift
+	}
+
+	// The wall clock times from start for shift are skipped by gap or repeated by overlap.
+	start := wallClock(at.Add(-time.Second)).Add(time.Second)
+	if !forward {
+		start = wallClock(at)
+	}
+
+	// The gap is not shifted to the first instant after it when that is due as well.
+	shifted, err := checkWall(segs, wallClock(at))
+	if err != nil {
+		return nil, err
+	}
+
+	events := []DSTEvent{}
+	for wall, n := start, 0; wall.Before(start.Add(shift)); wall, n = wall.Add(step), n+1 {
+		due, err := checkWall(segs, wall)
+		if err != nil {
+			return nil, err
+		}
+		if !due {
+			continue
+		}
+
+		event := DSTEvent{Transition: at, Wall: wall}
+		switch first := at.Add(time.Duration(n)*step - shift); {
+		case forward && wallFixed(segs) && !shifted:
+			event.Effect, event.Runs, shifted = DSTShifted, []time.Time{at}, true
+		case forward:
+			event.Effect, event.Runs = DSTSkipped, []time.Time{}
+		case wallFixed(segs):
+			event.Effect, event.Runs = DSTSkipped, []time.Time{first}
+		default:
+			event.Effect, event.Runs = DSTDuplicated, []time.Time{first, at.Add(time.Duration(n) * step)}
+		}
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+// checkWall checks if segs are due for wall clock time.
+func checkWall(segs []string, wall time.Time) (bool, error) {
+	pos, err := undueSegment(segs, wall, checkDue)
+
+	return pos < 0 && err == nil, err
+}
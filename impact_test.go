@@ -0,0 +1,88 @@
+package gronx
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDSTImpact(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	abort(err)
+	from, to := time.Date(2024, time.January, 1, 0, 0, 0, 0, newYork), time.Date(2024, time.December, 31, 0, 0, 0, 0, newYork)
+	format := "2006-01-02 15:04:05 -0700"
+
+	t.Run("dst impact fixed", func(t *testing.T) {
+		events, err := DSTImpact("30 1,2 * * *", newYork, from, to)
+		if err != nil || len(events) != 2 {
+			t.Fatalf("expected 2 events, got %v, %v", events, err)
+		}
+
+		expect := []struct{ transition, effect, wall string }{
+			{"2024-03-10 03:00:00 -0400", DSTShifted, "2024-03-10 02:30:00 +0000"},
+			{"2024-11-03 01:00:00 -0500", DSTSkipped, "2024-11-03 01:30:00 +0000"},
+		}
+		for i, e := range events {
+			if e.Transition.Format(format) != expect[i].transition || e.Effect != expect[i].effect || e.Wall.Format(format) != expect[i].wall {
+				t.Errorf("expected %v, got %v", expect[i], e)
+			}
+		}
+		if runs := events[0].Runs; len(runs) != 1 || runs[0].Format(format) != "2024-03-10 03:00:00 -0400" {
+			t.Errorf("expected shifted run at 03:00, got %v", runs)
+		}
+		if runs := events[1].Runs; len(runs) != 1 || runs[0].Format(format) != "2024-11-03 01:30:00 -0400" {
+			t.Errorf("expected run at first 01:30, got %v", runs)
+		}
+	})
+
+	t.Run("dst impact interval", func(t *testing.T) {
+		events, err := DSTImpact("*/30 * * * *", newYork, from, to)
+		if err != nil || len(events) != 4 {
+			t.Fatalf("expected 4 events, got %v, %v", events, err)
+		}
+
+		effects := []string{DSTSkipped, DSTSkipped, DSTDuplicated, DSTDuplicated}
+		for i, e := range events {
+			if e.Effect != effects[i] {
+				t.Errorf("#%d: expected %s, got %s", i, effects[i], e.Effect)
+			}
+		}
+		if runs := events[3].Runs; len(runs) != 2 || runs[0].Format(format) != "2024-11-03 01:30:00 -0400" || runs[1].Format(format) != "2024-11-03 01:30:00 -0500" {
+			t.Errorf("expected runs at both 01:30, got %v", runs)
+		}
+	})
+
+	t.Run("dst impact merged", func(t *testing.T) {
+		events, err := DSTImpact("0 2,3 * * *", newYork, from, from.AddDate(0, 6, 0))
+		if err != nil || len(events) != 1 || events[0].Effect != DSTSkipped || len(events[0].Runs) != 0 {
+			t.Errorf("expected skipped event as 03:00 is due, got %v, %v", events, err)
+		}
+	})
+
+	t.Run("dst impact none", func(t *testing.T) {
+		for _, expr := range []string{"0 12 * * *", "CRON_TZ=Asia/Kolkata 30 2 * * *"} {
+			events, err := DSTImpact(expr, nil, from, to)
+			if err != nil || events == nil || len(events) != 0 {
+				t.Errorf("%s: expected no events, got %v, %v", expr, events, err)
+			}
+		}
+		if _, err := DSTImpact("0 0 * * MOM", nil, from, to); err == nil {
+			t.Errorf("expected error, got nil")
+		}
+	})
+
+	t.Run("dst impact stable", func(t *testing.T) {
+		berlin, err := time.LoadLocation("Europe/Berlin")
+		abort(err)
+
+		events, err := DSTImpact("0,30 2 * * *", berlin, from, to.AddDate(1, 0, 0))
+		if err != nil || len(events) != 8 {
+			t.Fatalf("expected 8 events, got %v, %v", events, err)
+		}
+		for i := 1; i < len(events); i++ {
+			prev, e := events[i-1], events[i]
+			if e.Transition.Before(prev.Transition) || e.Transition.Equal(prev.Transition) && !e.Wall.After(prev.Wall) {
+				t.Errorf("expected sorted, got %v before %v", prev, e)
+			}
+		}
+	})
+}
@@ -0,0 +1,74 @@
+package gronx
+
+import "strings"
+
+// Info is the shape of cron expression as told by Inspect, for deciding how to check it.
+type Info struct {
+	// FieldCount is the number of fields in expr, or in the expr of macro.
+	FieldCount int
+	// HasSeconds tells that the first field is seconds.
+	HasSeconds bool
+	// HasYear tells that the last field is year.
+	HasYear bool
+	// UsesMacros tells that expr is macro like @daily.
+	UsesMacros bool
+	// UsesNames tells that expr has names of month or week day like JAN or MON.
+	UsesNames bool
+	// UsesSpecialTokens tells that expr has any of L, W, #, ?, H, R and ~.
+	UsesSpecialTokens bool
+}
+
+// Inspect gives the shape of cron expr without the CRON_TZ prefix, where 5 fields are the
+// standard ones, 6 fields have year last and 7 fields have seconds first and year last as in
+// Quartz (WithQuartz). It returns error if expr is invalid in that shape as per Segments.
+func Inspect(expr string) (Info, error) {
+	expr, _, err := exprZone(expr)
+	if err != nil {
+		return Info{}, err
+	}
+
+	gron := New()
+	if _, ok := macro(strings.Trim(expr, " \t")); !ok && len(strings.Fields(expr)) == 7 {
+		gron = New(WithQuartz())
+	}
+
+	return gron.Inspect(expr)
+}
+
+// Inspect gives the shape of cron expr as per the layout of Gronx, where the seconds (if
+// enabled) are first unless expr is macro. It returns error if expr is invalid as per Segments.
+func (g *Gronx) Inspect(expr string) (Info, error) {
+	expr, _, err := exprZone(expr)
+	if err != nil {
+		return Info{}, err
+	}
+	if _, err := g.segments(expr); err != nil {
+		return Info{}, err
+	}
+
+	expr = strings.Trim(expr, " \t")
+	e, isMacro := macro(expr)
+	if isMacro {
+		expr = e
+	}
+
+	info := Info{FieldCount: len(strings.Fields(expr)), UsesMacros: isMacro}
+	info.HasSeconds = g.seconds && !isMacro
+	info.HasYear = info.FieldCount > PosYear && (!info.HasSeconds || info.FieldCount > PosYear+1)
+
+	for pos, seg := range givenSegments(expr, info.HasSeconds) {
+		seg = strings.ToUpper(seg)
+		named := seg
+		switch pos {
+		case PosMonth:
+			named = replaceNames(seg, months)
+		case PosDayOfWeek:
+			named = replaceNames(seg, days)
+		}
+
+		info.UsesNames = info.UsesNames || named != seg
+		info.UsesSpecialTokens = info.UsesSpecialTokens || strings.ContainsAny(named, "LW#?HR~")
+	}
+
+	return info, nil
+}
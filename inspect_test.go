@@ -0,0 +1,77 @@
+package gronx
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestInspect(t *testing.T) {
+	tests := map[string]Info{
+		"* * * * *":                       {FieldCount: 5},
+		"  0 9 * jan-mar mon-fri ":        {FieldCount: 5, UsesNames: true},
+		"0 0 * * 0 2030":                  {FieldCount: 6, HasYear: true},
+		"0 0 12 ? * MON 2030":             {FieldCount: 7, HasSeconds: true, HasYear: true, UsesNames: true, UsesSpecialTokens: true},
+		"0 0 L * *":                       {FieldCount: 5, UsesSpecialTokens: true},
+		"0 0 * * 5#2":                     {FieldCount: 5, UsesSpecialTokens: true},
+		"0 0 * * WED,THU,FRI":             {FieldCount: 5, UsesNames: true},
+		"@daily":                          {FieldCount: 5, UsesMacros: true},
+		"CRON_TZ=Asia/Tokyo 30 4 * * 1-5": {FieldCount: 5},
+	}
+
+	for expr, expect := range tests {
+		t.Run("inspect "+expr, func(t *testing.T) {
+			actual, err := Inspect(expr)
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if actual != expect {
+				t.Errorf("expected %+v, got %+v", expect, actual)
+			}
+		})
+	}
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, expr := range []string{"* * * *", "* * * * * * * *", "61 * * * *", "0 0 12 * * MON 2030", "@reboot", "@every 1h", "TZ=Mars/Base * * * * *"} {
+			if _, err := Inspect(expr); err == nil {
+				t.Errorf("expected error for %s, got nil", expr)
+			}
+		}
+		if _, err := Inspect("* * * *"); !errors.Is(err, ErrSegmentCount) {
+			t.Errorf("expected %v, got %v", ErrSegmentCount, err)
+		}
+	})
+}
+
+func TestGronxInspect(t *testing.T) {
+	tests := []struct {
+		opts   []Option
+		expr   string
+		expect Info
+	}{
+		{[]Option{WithSecondsField()}, "*/5 * * * * *", Info{FieldCount: 6, HasSeconds: true}},
+		{[]Option{WithSecondsField()}, "@hourly", Info{FieldCount: 5, UsesMacros: true}},
+		{[]Option{WithQuartz()}, "0 0 12 1 * ?", Info{FieldCount: 6, HasSeconds: true, UsesSpecialTokens: true}},
+		{[]Option{WithQuartz()}, "0 0 12 1 * ? 2030", Info{FieldCount: 7, HasSeconds: true, HasYear: true, UsesSpecialTokens: true}},
+		{[]Option{WithHashKey("job")}, "H 0 * * *", Info{FieldCount: 5, UsesSpecialTokens: true}},
+	}
+
+	for _, test := range tests {
+		t.Run("inspect "+test.expr, func(t *testing.T) {
+			gron := New(test.opts...)
+			actual, err := gron.Inspect(test.expr)
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if actual != test.expect {
+				t.Errorf("expected %+v, got %+v", test.expect, actual)
+			}
+		})
+	}
+
+	t.Run("invalid", func(t *testing.T) {
+		gron := New(WithSecondsField())
+		if _, err := gron.Inspect("* * * * *"); !errors.Is(err, ErrSegmentCount) {
+			t.Errorf("expected %v, got %v", ErrSegmentCount, err)
+		}
+	})
+}
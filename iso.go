@@ -0,0 +1,65 @@
+package gronx
+
+import (
+	"errors"
+	"strings"
+)
+
+// isoExpr translates sunday names in week day field of expr at given position to ISO week day unless
+// expr is macro, which keeps its meaning. It returns expr and whether it is to be treated as ISO.
+func isoExpr(expr string, pos int) (string, bool) {
+	trimmed := strings.Trim(expr, " \t")
+	if _, ok := macro(trimmed); ok {
+		return expr, false
+	}
+
+	fields := SpaceRe.Split(trimmed, -1)
+	if pos < len(fields) {
+		fields[pos] = isoSundays(fields[pos])
+	}
+
+	return strings.Join(fields, " "), true
+}
+
+// isoSundays replaces the names of sunday (or names followed by L) in week day segment with 7,
+// which is sunday in ISO week days, including the ones registered by RegisterDayNames.
+func isoSundays(segment string) string {
+	namesMu.RLock()
+	defer namesMu.RUnlock()
+
+	return nameRe.ReplaceAllStringFunc(segment, func(name string) string {
+		upper, suffix := strings.ToUpper(name), ""
+		num, ok := days[upper]
+		if !ok && strings.HasSuffix(upper, "L") {
+			upper, suffix = upper[:len(upper)-1], "L"
+			num, ok = days[upper]
+		}
+		if ok && num == 0 {
+			return "7" + suffix
+		}
+
+		return name
+	})
+}
+
+// isoWeekday translates week day segment from ISO (1 is monday, 7 is sunday) to cron numbers.
+// Since cron also has 7 for sunday, only */n needs to count from 1. It errors for 0.
+func isoWeekday(segment string) (string, error) {
+	offsets := strings.Split(segment, ",")
+	for i, offset := range offsets {
+		if strings.HasPrefix(offset, "*/") {
+			offsets[i] = "1-7" + offset[1:]
+			continue
+		}
+
+		value := strings.Split(strings.Split(offset, "/")[0], "#")[0]
+		for _, day := range strings.Split(value, "-") {
+			if strings.TrimSuffix(day, "L") == "0" {
+				err := errors.New("week day should be 1-7 in ISO mode: " + segment)
+				return "", fieldErr(PosDayOfWeek, segment, ReasonOutOfRange, err)
+			}
+		}
+	}
+
+	return strings.Join(offsets, ","), nil
+}
@@ -0,0 +1,70 @@
+package gronx
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWithISOWeekday(t *testing.T) {
+	gron := New(WithISOWeekday())
+	tests := []Case{
+		{"0 0 * * 1", "2021-04-19 00:00:00", true},
+		{"0 0 * * MON", "2021-04-19 00:00:00", true},
+		{"0 0 * * 7", "2021-04-18 00:00:00", true},
+		{"0 0 * * SUN", "2021-04-18 00:00:00", true},
+		{"0 0 * * sun", "2021-04-18 00:00:00", true},
+		{"0 0 * * FRIDAY-SUNDAY", "2021-04-18 00:00:00", true},
+		{"0 0 * * 6-7", "2021-04-18 00:00:00", true},
+		{"0 0 * * FRI-SUN", "2021-04-18 00:00:00", true},
+		{"0 0 * * FRI-SUN", "2021-04-15 00:00:00", false},
+		{"0 0 * * */2", "2021-04-19 00:00:00", true},
+		{"0 0 * * */2", "2021-04-18 00:00:00", true},
+		{"0 0 * * */2", "2021-04-20 00:00:00", false},
+		{"0 0 * * 7L", "2021-04-25 00:00:00", true},
+		{"0 0 * * 7#3", "2021-04-18 00:00:00", true},
+		{"@weekly", "2021-04-18 00:00:00", true},
+		{"@weekend", "2021-04-17 00:00:00", true},
+	}
+
+	for _, test := range tests {
+		t.Run("iso weekday "+test.Expr+" "+test.Ref, func(t *testing.T) {
+			actual, err := test.run(gron)
+			if err != nil || actual != test.Expect {
+				t.Errorf("expected %v, got %v, %v", test.Expect, actual, err)
+			}
+		})
+	}
+
+	for _, expr := range []string{"0 0 * * 0", "0 0 * * 0-5", "0 0 * * 5-0", "0 0 * * 0L", "0 0 * * 0#2", "0 0 * * 1,0", "0 0 * SUN *", "0 0 SUNDAY * *"} {
+		t.Run("iso weekday err "+expr, func(t *testing.T) {
+			if gron.IsValid(expr) {
+				t.Errorf("expected false, got true")
+			}
+			if plain := New(); !plain.IsValid(expr) != strings.Contains(expr, "SUN") {
+				t.Errorf("expected valid without ISO mode unless SUN is out of week day")
+			}
+		})
+	}
+
+	t.Run("iso weekday seconds", func(t *testing.T) {
+		secs := New(WithISOWeekday(), WithSecondsField())
+		ref, _ := time.Parse(dateFormat, "2021-04-18 00:00:00")
+		if due, err := secs.IsDue("0 0 0 * * SUN", ref); err != nil || !due {
+			t.Errorf("expected true, got %v, %v", due, err)
+		}
+		if secs.IsValid("0 0 0 * SUN *") {
+			t.Errorf("expected false, got true")
+		}
+	})
+
+	t.Run("iso weekday prev next", func(t *testing.T) {
+		ref, _ := time.Parse(dateFormat, "2021-04-19 12:00:00")
+		if prev, err := gron.GetPrev("0 0 * * 7", ref); err != nil || prev.Format(dateFormat) != "2021-04-18 00:00:00" {
+			t.Errorf("expected 2021-04-18 00:00:00, got %v, %v", prev, err)
+		}
+		if next, err := gron.GetNext("0 0 * * 3", ref); err != nil || next.Format(dateFormat) != "2021-04-21 00:00:00" {
+			t.Errorf("expected 2021-04-21 00:00:00, got %v, %v", next, err)
+		}
+	})
+}
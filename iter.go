@@ -0,0 +1,32 @@
+//go:build go1.23
+// +build go1.23
+
+package gronx
+
+import (
+	"iter"
+	"time"
+)
+
+// Iter gives an iterator over successive due times for given cron expr on or after from.
+// The expr is compiled once like Parse, and each iteration starts afresh from the given time
+// without any state of its own, so it is safe to run alongside other operations on the same
+// Gronx. It stops once no more due time can be found within the search horizon.
+func (g *Gronx) Iter(expr string, from time.Time) (iter.Seq[time.Time], error) {
+	e, err := g.Parse(expr)
+	if err != nil {
+		return nil, err
+	}
+	from, check := inZone(from, e.loc), g.check(e)
+
+	return func(yield func(time.Time) bool) {
+		for ref := from; ; {
+			next, ok, err := e.nextUntil(ref, g.horizon(ref, false), check)
+			if err != nil || !ok || !yield(next) {
+				return
+			}
+
+			ref = next.Add(e.span())
+		}
+	}, nil
+}
@@ -0,0 +1,114 @@
+//go:build go1.23
+// +build go1.23
+
+package gronx
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestIter(t *testing.T) {
+	gron := New()
+	from, _ := time.Parse(dateFormat, "2021-04-19 12:54:09")
+
+	t.Run("iter", func(t *testing.T) {
+		seq, err := gron.Iter("*/15 * * * *", from)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		expect := []string{"2021-04-19 13:00:00", "2021-04-19 13:15:00", "2021-04-19 13:30:00"}
+		for run := 0; run < 2; run++ {
+			i := 0
+			for next := range seq {
+				if actual := next.Format(dateFormat); actual != expect[i] {
+					t.Errorf("expected %v, got %v", expect[i], actual)
+				}
+				if i++; i == len(expect) {
+					break
+				}
+			}
+		}
+	})
+
+	t.Run("iter seconds", func(t *testing.T) {
+		gron := New(WithSecondsField())
+		seq, err := gron.Iter("*/20 * * * * *", from)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		expect := []string{"2021-04-19 12:54:20", "2021-04-19 12:54:40", "2021-04-19 12:55:00"}
+		i := 0
+		for next := range seq {
+			if actual := next.Format(dateFormat); actual != expect[i] {
+				t.Errorf("expected %v, got %v", expect[i], actual)
+			}
+			if i++; i == len(expect) {
+				break
+			}
+		}
+	})
+
+	t.Run("iter every", func(t *testing.T) {
+		seq, err := gron.Iter("@every 90s", from)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		expect := []string{"2021-04-19 12:55:30", "2021-04-19 12:57:00", "2021-04-19 12:58:30"}
+		i := 0
+		for next := range seq {
+			if actual := next.Format(dateFormat); actual != expect[i] {
+				t.Errorf("expected %v, got %v", expect[i], actual)
+			}
+			if i++; i == len(expect) {
+				break
+			}
+		}
+	})
+
+	t.Run("iter exhausted", func(t *testing.T) {
+		seq, err := gron.Iter("0 0 1 1,7 * 2021", from)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		count := 0
+		for range seq {
+			count++
+		}
+		if count != 1 {
+			t.Errorf("expected 1 due time, got %d", count)
+		}
+	})
+
+	t.Run("iter concurrent", func(t *testing.T) {
+		seq, _ := gron.Iter("* * * * *", from)
+		wg := sync.WaitGroup{}
+		for i := 0; i < 4; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				prev := time.Time{}
+				for next := range seq {
+					if !next.After(prev) {
+						t.Errorf("expected %v after %v", next, prev)
+					}
+					if prev = next; next.Sub(from) > time.Hour {
+						break
+					}
+				}
+			}()
+		}
+		wg.Wait()
+	})
+
+	t.Run("iter invalid", func(t *testing.T) {
+		if _, err := gron.Iter("* * *", from); err == nil {
+			t.Errorf("expected error, got nil")
+		}
+	})
+}
@@ -0,0 +1,105 @@
+package gronx
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// kubeMacros are the descriptors Kubernetes CronJob (robfig/cron) accepts, besides @every.
+var kubeMacros = map[string]bool{
+	"@yearly": true, "@annually": true, "@monthly": true, "@weekly": true,
+	"@daily": true, "@midnight": true, "@hourly": true,
+}
+
+// ValidateForKubernetes checks if cron expr is valid for Kubernetes CronJob and is due there
+// the same as here, ie it is valid for Validate and has no construct that the robfig/cron
+// parser of Kubernetes rejects (eg: L, W, #, H, full names, week day 7, year or wrapping range)
+// or treats differently (eg: both day of month and week day restricted, which is due on either
+// of them there). The TZ= and CRON_TZ= prefixes are rejected in favor of spec.timeZone.
+// It returns error telling the reason if any, which is ValidationErrors if there are many.
+func ValidateForKubernetes(expr string) error {
+	expr = strings.Trim(expr, " \t")
+	if upper := strings.ToUpper(expr); strings.HasPrefix(upper, "TZ=") || strings.HasPrefix(upper, "CRON_TZ=") {
+		return kindErr(ErrDialect, "time zone prefix is not supported by Kubernetes, use spec.timeZone instead: "+expr)
+	}
+
+	if strings.HasPrefix(expr, "@") {
+		if _, ok, err := parseEvery(expr); ok {
+			return err
+		}
+		if !kubeMacros[strings.ToLower(expr)] {
+			return kindErr(ErrDialect, "macro is not supported by Kubernetes: "+expr)
+		}
+		return nil
+	}
+
+	fields := SpaceRe.Split(expr, -1)
+	if len(fields) != 5 {
+		return kindErr(ErrDialect, "Kubernetes cron expr should contain 5 segments, without seconds or year: "+expr)
+	}
+	segs, errs := parseSegments(expr)
+	if err := validate(segs, errs); err != nil {
+		return givenValidation(err, fields)
+	}
+
+	errs = []error{}
+	for pos, field := range fields {
+		if construct := kubeConstruct(strings.ToUpper(field), segs[pos], pos); construct != "" {
+			err := fmt.Errorf("%s is not supported in %s segment by Kubernetes: %s", construct, segmentNames[pos], field)
+			errs = append(errs, fieldErr(pos, field, ReasonDialect, err))
+		}
+	}
+	if len(errs) == 0 && kubeDayOr(fields) {
+		err := errors.New("day of month and week day are both restricted, Kubernetes is due on either of them: " + expr)
+		errs = append(errs, fieldErr(PosDayOfWeek, fields[PosDayOfWeek], ReasonDialect, err))
+	}
+
+	return validate(segs, errs)
+}
+
+// kubeNames are the names robfig/cron has, only 3 letters in English.
+var kubeNames = strings.Fields("JAN FEB MAR APR MAY JUN JUL AUG SEP OCT NOV DEC SUN MON TUE WED THU FRI SAT")
+
+// kubeConstruct tells the construct in segment at given position, as given and as normalized,
+// that robfig/cron doesn't have if any. It has *, ?, numbers, the names it knows, ranges that
+// don't wrap around, steps and lists.
+func kubeConstruct(field, segment string, pos int) string {
+	if strings.Contains(field, "#") {
+		return "nth week day #"
+	}
+
+	for _, name := range nameRe.FindAllString(field, -1) {
+		switch {
+		case nameField(name) == "":
+			return fmt.Sprintf("modifier %q", name)
+		case !inStrings(kubeNames, name):
+			return fmt.Sprintf("name %q", name)
+		}
+	}
+
+	return rangeConstruct(segment, pos)
+}
+
+// inStrings checks if s is one of strs.
+func inStrings(strs []string, s string) bool {
+	for _, str := range strs {
+		if str == s {
+			return true
+		}
+	}
+
+	return false
+}
+
+// kubeDayOr checks if robfig/cron would be due on either of day of month and week day, ie both are
+// restricted, where * or ? with step other than 1 is restricted too.
+func kubeDayOr(fields []string) bool {
+	for _, field := range []string{fields[PosDayOfMonth], fields[PosDayOfWeek]} {
+		if field == "*" || field == "?" || field == "*/1" || field == "?/1" {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,59 @@
+package gronx
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestValidateForKubernetes(t *testing.T) {
+	for _, expr := range []string{
+		"*/5 * * * *", "0 9 * * MON-FRI", "0 0 1 JAN,JUL *", "0 0 ? * SUN", "0 0 1 * ?", "@hourly", "@midnight",
+		"@every 90m", "0 22 * * 0", "0 0 */2 * *", "0 0 * * */1", "30 2 1-15 * *",
+	} {
+		t.Run("kubernetes "+expr, func(t *testing.T) {
+			if err := ValidateForKubernetes(expr); err != nil {
+				t.Errorf("expected no error, got %v", err)
+			}
+		})
+	}
+
+	// The expressions that didn't run as expected on Kubernetes, with why.
+	tests := map[string]string{
+		"CRON_TZ=UTC 0 0 * * *":      "use spec.timeZone",
+		"TZ=Europe/Berlin 0 0 * * *": "use spec.timeZone",
+		"@reboot":                    "macro is not supported",
+		"@always":                    "macro is not supported",
+		"0 0 * * * 2025":             "should contain 5 segments",
+		"0 0 0 * * *":                "should contain 5 segments",
+		"0 0 L * *":                  `modifier "L" is not supported in day of month segment`,
+		"0 0 15W * *":                `modifier "W"`,
+		"0 0 * * 5L":                 `modifier "L" is not supported in week day segment`,
+		"0 0 * * 1#1":                "nth week day # is not supported",
+		"0~30 * * * *":               `invalid value "0~30"`,
+		"0 0 * * 7":                  "week day 7 is not supported",
+		"0 0 * * MON-SUN":            "week day 7 is not supported",
+		"0 22-2 * * *":               "range wrapping around is not supported",
+		"0 0 * * MONDAY":             `name "MONDAY" is not supported`,
+		"0 0 1 JANUARY *":            `name "JANUARY" is not supported`,
+		"0 0 13 * FRI":               "Kubernetes is due on either of them",
+		"0 0 */2 * 1-5":              "Kubernetes is due on either of them",
+		"61 * * * *":                 "value 61 in minute segment should be 0-59",
+		"H * * * *":                  `invalid value "H"`,
+	}
+	for expr, expect := range tests {
+		t.Run("kubernetes err "+expr, func(t *testing.T) {
+			if err := ValidateForKubernetes(expr); err == nil || !strings.Contains(err.Error(), expect) {
+				t.Errorf("expected %s, got %v", expect, err)
+			}
+		})
+	}
+
+	t.Run("kubernetes errors", func(t *testing.T) {
+		err := ValidateForKubernetes("0 0 L * 5L")
+		var verrs ValidationErrors
+		if !errors.As(err, &verrs) || len(verrs) != 2 || !errors.Is(err, ErrDialect) {
+			t.Errorf("expected 2 dialect errors, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,68 @@
+package gronx
+
+import (
+	"strings"
+)
+
+// errQuartzOnly is the error for 7 segments when not in Quartz mode.
+var errQuartzOnly = kindErr(ErrSegmentCount, "expr with 7 segments (seconds first, year last) needs Quartz mode, use WithQuartz")
+
+// Layout is the arrangement of segments in cron expression.
+type Layout int
+
+const (
+	// LayoutStandard is 5 segments from minute to week day.
+	LayoutStandard Layout = iota
+	// LayoutYear is 6 segments with year last, the default of Gronx.
+	LayoutYear
+	// LayoutSeconds is 6 segments with seconds first, see WithSecondsField.
+	LayoutSeconds
+	// LayoutQuartz is 6 or 7 segments with seconds first and optional year last, see WithQuartz.
+	LayoutQuartz
+)
+
+func (l Layout) String() string {
+	switch l {
+	case LayoutStandard:
+		return "standard"
+	case LayoutYear:
+		return "year"
+	case LayoutSeconds:
+		return "seconds"
+	case LayoutQuartz:
+		return "quartz"
+	}
+
+	return "unknown"
+}
+
+// layoutOptions are the options to parse expr with for each layout.
+var layoutOptions = map[Layout][]Option{
+	LayoutStandard: {WithYearField()},
+	LayoutYear:     {WithYearField()},
+	LayoutSeconds:  {WithSecondsField()},
+	LayoutQuartz:   {WithQuartz()},
+}
+
+// DetectLayout reports the layouts in which cron expr is valid, as a heuristic for input
+// of unknown dialect. Eg: 0 0 * * * * is valid both with year last and with seconds first,
+// whereas 0 0 1 * * 2025 is valid only with year last. Macros are valid in every layout.
+// It returns empty slice if expr is valid in none of them.
+func DetectLayout(expr string) []Layout {
+	count := len(SpaceRe.Split(strings.Trim(expr, " \t"), -1))
+	_, isMacro := macro(strings.Trim(expr, " \t"))
+
+	layouts := []Layout{}
+	for _, layout := range []Layout{LayoutStandard, LayoutYear, LayoutSeconds, LayoutQuartz} {
+		if !isMacro && (layout == LayoutStandard) != (count == 5) {
+			continue
+		}
+
+		gron := New(append(layoutOptions[layout], WithHashKey(expr))...)
+		if segs, err := gron.segments(expr); err == nil && validate(segs, nil) == nil {
+			layouts = append(layouts, layout)
+		}
+	}
+
+	return layouts
+}
@@ -0,0 +1,91 @@
+package gronx
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDetectLayout(t *testing.T) {
+	tests := map[string][]Layout{
+		"* * * * *":                      {LayoutStandard},
+		"0 0 * * * *":                    {LayoutYear, LayoutSeconds, LayoutQuartz},
+		"0 0 1 * * 2025":                 {LayoutYear},
+		"30 */5 * * * MON":               {LayoutSeconds},
+		"30 */5 * ? * MON":               {LayoutSeconds, LayoutQuartz},
+		"0 0 0 ? * 7":                    {LayoutSeconds, LayoutQuartz},
+		"0 0 0 ? * 0":                    {LayoutSeconds},
+		"0 15 10 ? * MON-FRI 2025":       {LayoutQuartz},
+		"H H * * *":                      {LayoutStandard},
+		"@daily":                         {LayoutStandard, LayoutYear, LayoutSeconds, LayoutQuartz},
+		"* * *":                          {},
+		"A * * * * *":                    {},
+		"0 15 10 ? * MON-FRI 2025 extra": {},
+	}
+
+	for expr, expect := range tests {
+		t.Run("detect layout "+expr, func(t *testing.T) {
+			if actual := DetectLayout(expr); fmt.Sprint(actual) != fmt.Sprint(expect) {
+				t.Errorf("expected %v, got %v", expect, actual)
+			}
+		})
+	}
+
+	t.Run("layout string", func(t *testing.T) {
+		if actual := LayoutQuartz.String(); actual != "quartz" {
+			t.Errorf("expected quartz, got %v", actual)
+		}
+		if actual := Layout(9).String(); actual != "unknown" {
+			t.Errorf("expected unknown, got %v", actual)
+		}
+	})
+}
+
+func TestWithYearField(t *testing.T) {
+	ref, _ := time.Parse(dateFormat, "2021-04-19 00:30:00")
+	year, secs := New(WithSecondsField(), WithYearField()), New(WithSecondsField())
+
+	t.Run("year field due", func(t *testing.T) {
+		if due, err := year.IsDue("30 0 * * * 2021", ref); err != nil || !due {
+			t.Errorf("expected true, got %v, %v", due, err)
+		}
+		if due, err := secs.IsDue("0 30 0 * * *", ref); err != nil || !due {
+			t.Errorf("expected true, got %v, %v", due, err)
+		}
+		if _, err := year.IsDue("30 0 * * * MON", ref); err == nil {
+			t.Errorf("expected error for week day as year, got nil")
+		}
+	})
+
+	t.Run("year field prev", func(t *testing.T) {
+		prev, err := year.GetPrev("0 0 * * * 2020", ref)
+		if err != nil || prev.Format(dateFormat) != "2020-12-31 00:00:00" {
+			t.Errorf("expected 2020-12-31 00:00:00, got %v, %v", prev, err)
+		}
+		prev, err = secs.GetPrev("15 0 0 * * *", ref)
+		if err != nil || prev.Format(dateFormat) != "2021-04-19 00:00:15" {
+			t.Errorf("expected 2021-04-19 00:00:15, got %v, %v", prev, err)
+		}
+	})
+
+	t.Run("year field segments", func(t *testing.T) {
+		segs, err := year.Segments("0 0 * * * 2021")
+		if err != nil || len(segs) != 6 || segs[PosYear] != "2021" {
+			t.Errorf("expected year last, got %v, %v", segs, err)
+		}
+		segs, err = secs.Segments("30 0 0 * * *")
+		if err != nil || len(segs) != 7 || segs[PosYear] != "*" || segs[PosSecond] != "30" {
+			t.Errorf("expected seconds at PosSecond, got %v, %v", segs, err)
+		}
+	})
+
+	for _, gron := range []Gronx{year, secs} {
+		t.Run("year field quartz err", func(t *testing.T) {
+			_, err := gron.IsDue("0 15 10 ? * MON-FRI 2025")
+			if err == nil || !strings.Contains(err.Error(), "WithQuartz") {
+				t.Errorf("expected error pointing to WithQuartz, got %v", err)
+			}
+		})
+	}
+}
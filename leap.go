@@ -0,0 +1,61 @@
+package gronx
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// isLeap checks if year is a leap year as per the Gregorian calendar, ie 2000 is but 1900 is not.
+func isLeap(year int) bool {
+	return year%4 == 0 && (year%100 != 0 || year%400 == 0)
+}
+
+// monthDays gives the number of days in month of year, ie 29 for February of leap year.
+func monthDays(year int, month time.Month) int {
+	switch month {
+	case time.February:
+		if isLeap(year) {
+			return 29
+		}
+		return 28
+	case time.April, time.June, time.September, time.November:
+		return 30
+	}
+
+	return 31
+}
+
+// firstDay gives the earliest day of month allowed by values, ranges and steps of day of month
+// segment, or 1 if it has anything else like modifier or wrapping range.
+func firstDay(segment string) int {
+	first := 31
+	for _, offset := range strings.Split(segment, ",") {
+		values := strings.Split(strings.Split(offset, "/")[0], "-")
+		day, err := strconv.Atoi(values[0])
+		if err != nil {
+			return 1
+		}
+		if len(values) > 1 {
+			if end, err := strconv.Atoi(values[1]); err != nil || end < day {
+				return 1
+			}
+		}
+		if day < first {
+			first = day
+		}
+	}
+
+	return first
+}
+
+// shortMonth checks if the month of ref is too short for any day of month of segs, eg: 29th
+// in February of year that isn't leap, so that it is skipped as a whole. The week day should
+// be unrestricted, as either of them could be due with WithDayOr.
+func shortMonth(segs []string, ref time.Time) bool {
+	if dow := segs[PosDayOfWeek]; dow != "*" && dow != "?" {
+		return false
+	}
+
+	return firstDay(segs[PosDayOfMonth]) > monthDays(ref.Year(), ref.Month())
+}
@@ -0,0 +1,78 @@
+package gronx
+
+import (
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestIsLeap(t *testing.T) {
+	tests := map[int]bool{2000: true, 1900: false, 2100: false, 2024: true, 2025: false, 1996: true}
+	for year, expect := range tests {
+		t.Run("is leap "+strconv.Itoa(year), func(t *testing.T) {
+			if actual := isLeap(year); actual != expect {
+				t.Errorf("expected %v, got %v", expect, actual)
+			}
+			if days := monthDays(year, time.February); (days == 29) != expect {
+				t.Errorf("expected leap %v, got %d days", expect, days)
+			}
+		})
+	}
+}
+
+func TestFirstDay(t *testing.T) {
+	tests := map[string]int{"29": 29, "30,31": 30, "29-31": 29, "31/2": 31, "*/2": 1, "30-2": 1, "L": 1, "15W,30": 1, "5,29": 5}
+	for seg, expect := range tests {
+		t.Run("first day "+seg, func(t *testing.T) {
+			if actual := firstDay(seg); actual != expect {
+				t.Errorf("expected %v, got %v", expect, actual)
+			}
+		})
+	}
+}
+
+func TestLeapDay(t *testing.T) {
+	gron, dayOr := New(), New(WithDayOr())
+	tests := []struct {
+		gron       Gronx
+		expr, ref  string
+		prev, next string
+	}{
+		{gron, "0 0 29 2 *", "2025-03-10 00:00:00", "2024-02-29 00:00:00", "2028-02-29 00:00:00"},
+		{gron, "0 0 29 2 *", "2000-01-01 00:00:00", "1996-02-29 00:00:00", "2000-02-29 00:00:00"},
+		{gron, "0 0 29 2 *", "2003-06-01 00:00:00", "2000-02-29 00:00:00", "2004-02-29 00:00:00"},
+		{gron, "0 0 29 2 *", "2024-02-29 00:00:00", "2024-02-29 00:00:00", "2024-02-29 00:00:00"},
+		{gron, "0 0 29-31 2 *", "2025-03-10 00:00:00", "2024-02-29 00:00:00", "2028-02-29 00:00:00"},
+		{gron, "0 0 29 2 4", "2025-03-10 00:00:00", "2024-02-29 00:00:00", "2052-02-29 00:00:00"},
+		{gron, "0 0 29 2 MON", "2025-03-10 00:00:00", "2016-02-29 00:00:00", "2044-02-29 00:00:00"},
+		{gron, "0 0 29 2 * 2096-2099", "2025-03-10 00:00:00", "", "2096-02-29 00:00:00"},
+		{dayOr, "0 0 29 2 MON", "2025-03-10 00:00:00", "2025-02-24 00:00:00", "2026-02-02 00:00:00"},
+	}
+
+	for _, test := range tests {
+		t.Run("leap day "+test.expr+" "+test.ref, func(t *testing.T) {
+			ref, _ := time.Parse(dateFormat, test.ref)
+			if prev, err := test.gron.GetPrev(test.expr, ref); test.prev == "" && err == nil {
+				t.Errorf("expected error, got %v", prev)
+			} else if test.prev != "" && (err != nil || prev.Format(dateFormat) != test.prev) {
+				t.Errorf("expected %s, got %v, %v", test.prev, prev, err)
+			}
+			if next, err := test.gron.GetNext(test.expr, ref); err != nil || next.Format(dateFormat) != test.next {
+				t.Errorf("expected %s, got %v, %v", test.next, next, err)
+			}
+		})
+	}
+
+	t.Run("leap day is due", func(t *testing.T) {
+		for _, ref := range []string{"2000-02-29 00:00:00", "2024-02-29 00:00:00"} {
+			at, _ := time.Parse(dateFormat, ref)
+			if due, err := gron.IsDue("0 0 L 2 *", at); err != nil || !due {
+				t.Errorf("expected true for %s, got %v, %v", ref, due, err)
+			}
+		}
+		at, _ := time.Parse(dateFormat, "2100-02-28 00:00:00")
+		if due, err := gron.IsDue("0 0 L 2 *", at); err != nil || !due {
+			t.Errorf("expected true for 2100-02-28, got %v, %v", due, err)
+		}
+	})
+}
@@ -0,0 +1,141 @@
+package gronx
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// The codes of Warning, as for machines.
+const (
+	WarnDayAndWeekDay = "day-and-week-day"
+	WarnRedundantStep = "redundant-step"
+	WarnDuplicate     = "duplicate"
+	WarnFullRange     = "full-range"
+	WarnEveryMinute   = "every-minute"
+	WarnWeekDayYear   = "week-day-as-year"
+)
+
+// Warning is the suspicious but legal part of cron expression at Pos (eg: PosMinute), with the
+// Code (eg: WarnDuplicate) and Message for humans.
+type Warning struct {
+	Code    string
+	Pos     int
+	Message string
+}
+
+func (w Warning) String() string {
+	return w.Code + ": " + w.Message
+}
+
+// periodWords are the words in job name that tell it runs once in a while, not every minute.
+var periodWords = []string{"hourly", "daily", "nightly", "weekly", "monthly", "yearly", "annual", "report", "backup"}
+
+// Lint gives the warnings for suspicious parts of cron expr which are legal but likely not meant,
+// in order of position: both day of month and week day restricted, step of 1, duplicates in list,
+// range of whole segment, * minute when the hour is restricted or job name (if given) says it
+// runs once in a while, and year segment that looks like week day. It doesn't validate expr.
+func Lint(expr string, job ...string) []Warning {
+	warns := []Warning{}
+	if strings.HasPrefix(strings.Trim(expr, " \t"), "@") {
+		return warns
+	}
+
+	segs := normalize(expr)
+	if len(segs) < 5 || len(segs) > 6 {
+		return warns
+	}
+
+	for pos, seg := range segs {
+		if pos == PosYear {
+			warns = append(warns, yearWarns(seg)...)
+			continue
+		}
+		warns = append(warns, segmentWarns(seg, pos)...)
+		if pos == PosMinute {
+			warns = append(warns, minuteWarns(segs, job)...)
+		}
+		if pos == PosDayOfWeek && dayRestricted(segs) {
+			msg := fmt.Sprintf("both day of month %s and week day %s are restricted, which must both be due unless WithDayOr", segs[PosDayOfMonth], seg)
+			warns = append(warns, Warning{WarnDayAndWeekDay, pos, msg})
+		}
+	}
+
+	return warns
+}
+
+// segmentWarns gives the warnings for offsets of segment at given position, other than year.
+func segmentWarns(seg string, pos int) []Warning {
+	warns, seen := []Warning{}, map[string]bool{}
+	min, max := valueBounds(pos)
+	if pos == PosDayOfWeek {
+		max = 6
+	}
+
+	for _, offset := range strings.Split(seg, ",") {
+		if seen[offset] {
+			warns = append(warns, Warning{WarnDuplicate, pos, fmt.Sprintf("%s is given more than once in %s segment: %s", offset, segmentNames[pos], seg)})
+			continue
+		}
+		seen[offset] = true
+
+		parts := strings.Split(offset, "/")
+		if len(parts) == 2 && parts[1] == "1" {
+			warns = append(warns, Warning{WarnRedundantStep, pos, fmt.Sprintf("step of 1 in %s segment is same as without it: %s", segmentNames[pos], offset)})
+		}
+
+		values := strings.Split(parts[0], "-")
+		if len(values) != 2 {
+			continue
+		}
+		lo, err1 := strconv.Atoi(values[0])
+		hi, err2 := strconv.Atoi(values[1])
+		if err1 == nil && err2 == nil && lo == min && hi >= max {
+			warns = append(warns, Warning{WarnFullRange, pos, fmt.Sprintf("range %s covers whole %s segment, same as *: %s", parts[0], segmentNames[pos], offset)})
+		}
+	}
+
+	return warns
+}
+
+// minuteWarns gives the warning for * minute if hour is restricted or job name says it runs
+// once in a while, as it is then due every minute of the hour.
+func minuteWarns(segs []string, job []string) []Warning {
+	if segs[PosMinute] != "*" && segs[PosMinute] != "*/1" {
+		return nil
+	}
+
+	name := strings.ToLower(strings.Join(job, " "))
+	for _, word := range periodWords {
+		if strings.Contains(name, word) {
+			msg := fmt.Sprintf("job %q is due every minute as minute segment is %s", strings.Join(job, " "), segs[PosMinute])
+			return []Warning{{WarnEveryMinute, PosMinute, msg}}
+		}
+	}
+	if hour := segs[PosHour]; hour != "*" && hour != "?" && !strings.HasPrefix(hour, "*/") {
+		msg := fmt.Sprintf("due every minute of hour %s as minute segment is %s, maybe 0 is meant", hour, segs[PosMinute])
+		return []Warning{{WarnEveryMinute, PosMinute, msg}}
+	}
+
+	return nil
+}
+
+// yearWarns gives the warning for year segment that looks like week day, ie names of week day
+// or numbers up to 7.
+func yearWarns(seg string) []Warning {
+	if seg == "*" || seg == "?" {
+		return nil
+	}
+
+	msg := "year segment looks like week day, maybe seconds first is meant with WithSecondsField: " + seg
+	if name := nameRe.FindString(seg); name != "" && nameField(name) == segmentNames[PosDayOfWeek] {
+		return []Warning{{WarnWeekDayYear, PosYear, msg}}
+	}
+	for _, value := range strings.FieldsFunc(seg, func(r rune) bool { return r == ',' || r == '-' || r == '/' }) {
+		if num, err := strconv.Atoi(value); err != nil || num > 7 {
+			return nil
+		}
+	}
+
+	return []Warning{{WarnWeekDayYear, PosYear, msg}}
+}
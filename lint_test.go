@@ -0,0 +1,62 @@
+package gronx
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestLint(t *testing.T) {
+	tests := map[string][]string{
+		"* * * * *":          {},
+		"0 0 * * *":          {},
+		"@daily":             {},
+		"0 0 13 * FRI":       {WarnDayAndWeekDay},
+		"*/1 * * * *":        {WarnRedundantStep},
+		"0 9-17/1 * * *":     {WarnRedundantStep},
+		"5,10,5 * * * *":     {WarnDuplicate},
+		"0-59 * * * *":       {WarnFullRange},
+		"0 0 * 1-12 0-6":     {WarnFullRange, WarnFullRange},
+		"0 0 * * 0-7":        {WarnFullRange},
+		"* 3 * * *":          {WarnEveryMinute},
+		"*/1 3 * * *":        {WarnRedundantStep, WarnEveryMinute},
+		"* */2 * * *":        {},
+		"0 0 * * * 1-5":      {WarnWeekDayYear},
+		"0 0 * * * MON":      {WarnWeekDayYear},
+		"0 0 1 1 * 2025":     {},
+		"0 0 1 1 * 2025/2":   {},
+		"1-1,1-1 0 1-31 * 1": {WarnDuplicate, WarnFullRange, WarnDayAndWeekDay},
+	}
+
+	for expr, expect := range tests {
+		t.Run("lint "+expr, func(t *testing.T) {
+			codes := []string{}
+			for _, warn := range Lint(expr) {
+				codes = append(codes, warn.Code)
+			}
+			if fmt.Sprint(codes) != fmt.Sprint(expect) {
+				t.Errorf("expected %v, got %v", expect, Lint(expr))
+			}
+		})
+	}
+
+	t.Run("lint job name", func(t *testing.T) {
+		warns := Lint("* * * * *", "nightly-backup")
+		if len(warns) != 1 || warns[0].Code != WarnEveryMinute || warns[0].Pos != PosMinute {
+			t.Errorf("expected every minute warning, got %v", warns)
+		}
+		if warns := Lint("* * * * *", "queue-worker"); len(warns) != 0 {
+			t.Errorf("expected no warnings, got %v", warns)
+		}
+	})
+
+	t.Run("lint warning", func(t *testing.T) {
+		warns := Lint("0 0 13 * FRI")
+		if len(warns) != 1 || warns[0].Pos != PosDayOfWeek {
+			t.Fatalf("expected week day warning, got %v", warns)
+		}
+		expect := "day-and-week-day: both day of month 13 and week day 5 are restricted, which must both be due unless WithDayOr"
+		if warns[0].String() != expect {
+			t.Errorf("expected %s, got %s", expect, warns[0])
+		}
+	})
+}
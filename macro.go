@@ -0,0 +1,117 @@
+package gronx
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// macroMu guards expressions against concurrent registration.
+var macroMu sync.RWMutex
+
+// macroGen counts the registrations of macros, guarded by macroMu.
+var macroGen uint64
+
+// builtins are the names of macros that come with gronx.
+var builtins = func() map[string]bool {
+	names := make(map[string]bool, len(expressions))
+	for name := range expressions {
+		names[name] = true
+	}
+
+	return names
+}()
+
+// RegisterMacro registers a user defined macro like @business-hours for given cron expr,
+// so it can be used wherever cron expression is accepted. The name is case insensitive and
+// the expr is validated like Validate. It errors if the name is already registered unless
+// overwrite is true, and with MacroError if the name is reserved, ie built-in, @reboot or
+// starting with @every. It is safe for concurrent use.
+func RegisterMacro(name, expr string, overwrite ...bool) error {
+	name = strings.ToLower(strings.Trim(name, " \t"))
+	if len(name) < 2 || name[0] != '@' || strings.ContainsAny(name, " \t\n") {
+		return errors.New("macro name should start with @ and not contain space: " + name)
+	}
+	if builtins[name] || name == "@reboot" || strings.HasPrefix(name, "@every") {
+		return &MacroError{Macro: name, Reserved: true}
+	}
+
+	err := Validate(expr)
+	segs := []string{}
+	if err == nil {
+		// The @reboot is valid, but not due at any time.
+		segs, err = Segments(expr)
+	}
+	if err != nil {
+		return fmt.Errorf("invalid cron expression for macro %s: %w", name, err)
+	}
+
+	macroMu.Lock()
+	defer macroMu.Unlock()
+
+	if _, ok := expressions[name]; ok && (len(overwrite) == 0 || !overwrite[0]) {
+		return errors.New("macro name is already registered: " + name)
+	}
+
+	expressions[name] = strings.Join(segs, " ")
+	macroGen++
+
+	return nil
+}
+
+// Macros gives the copy of all registered macros including built-ins, by name.
+func Macros() map[string]string {
+	macroMu.RLock()
+	defer macroMu.RUnlock()
+
+	macros := make(map[string]string, len(expressions))
+	for name, expr := range expressions {
+		macros[name] = expr
+	}
+
+	return macros
+}
+
+// Macros gives the copy of all registered macros like Macros, with @weekly as per WithWeekStart.
+func (g *Gronx) Macros() map[string]string {
+	macros := Macros()
+	if dow, ok := g.weeklyDay("@weekly"); ok {
+		macros["@weekly"] = strings.TrimSuffix(macros["@weekly"], "0") + dow
+	}
+
+	return macros
+}
+
+// weeklyDay gives the week day segment of @weekly as per week start if expr is it and week start
+// is not sunday, along with whether it is. It is not if @weekly is registered anew.
+func (g *Gronx) weeklyDay(expr string) (string, bool) {
+	if g.weekStart == time.Sunday || !strings.EqualFold(strings.Trim(expr, " \t"), "@weekly") {
+		return "", false
+	}
+	if weekly, _ := macro("@weekly"); weekly != "0 0 * * 0" {
+		return "", false
+	}
+
+	return strconv.Itoa(int(g.weekStart)), true
+}
+
+// macroVersion gives the count of registrations of macros so far.
+func macroVersion() uint64 {
+	macroMu.RLock()
+	defer macroMu.RUnlock()
+
+	return macroGen
+}
+
+// macro gets the cron expression registered for given name if any.
+func macro(name string) (string, bool) {
+	macroMu.RLock()
+	defer macroMu.RUnlock()
+
+	expr, ok := expressions[strings.ToLower(name)]
+
+	return expr, ok
+}
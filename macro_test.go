@@ -0,0 +1,97 @@
+package gronx
+
+import (
+	"errors"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestRegisterMacro(t *testing.T) {
+	gron := New()
+
+	t.Run("register macro", func(t *testing.T) {
+		if err := RegisterMacro("@Business-Hours", "0 9-17 * * MON-FRI"); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		ref, _ := time.Parse(dateFormat, "2021-04-19 10:00:00")
+		if due, err := gron.IsDue(" @business-hours ", ref); err != nil || !due {
+			t.Errorf("expected true, got %v, %v", due, err)
+		}
+		if next, err := gron.GetNext("@BUSINESS-HOURS", ref.Add(8*time.Hour)); err != nil || next.Format(dateFormat) != "2021-04-20 09:00:00" {
+			t.Errorf("expected 2021-04-20 09:00:00, got %v, %v", next, err)
+		}
+		if expr := Macros()["@business-hours"]; expr != "0 9-17 * * 1-5" {
+			t.Errorf("expected 0 9-17 * * 1-5, got %v", expr)
+		}
+	})
+
+	t.Run("register macro of macro", func(t *testing.T) {
+		if err := RegisterMacro("@nightly-backup", "@daily"); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if expr := Macros()["@nightly-backup"]; expr != "0 0 * * *" {
+			t.Errorf("expected 0 0 * * *, got %v", expr)
+		}
+	})
+
+	t.Run("register macro overwrite", func(t *testing.T) {
+		if err := RegisterMacro("@twice-daily", "0 0,12 * * *"); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if err := RegisterMacro("@twice-daily", "0 6,18 * * *"); err == nil {
+			t.Errorf("expected error, got nil")
+		}
+		if err := RegisterMacro("@twice-daily", "0 6,18 * * *", true); err != nil {
+			t.Errorf("expected no error, got %v", err)
+		}
+		if expr := Macros()["@twice-daily"]; expr != "0 6,18 * * *" {
+			t.Errorf("expected 0 6,18 * * *, got %v", expr)
+		}
+	})
+
+	t.Run("register macro reserved", func(t *testing.T) {
+		for _, name := range []string{"@DAILY", "@daily", "@reboot", "@every", "@everyday", "@Every-Hour"} {
+			var merr *MacroError
+			for _, overwrite := range []bool{false, true} {
+				err := RegisterMacro(name, "0 1 * * *", overwrite)
+				if !errors.As(err, &merr) || !merr.Reserved || errors.Is(err, ErrUnknownToken) {
+					t.Errorf("%s: expected reserved MacroError, got %v", name, err)
+				}
+			}
+		}
+		if expr := Macros()["@daily"]; expr != "0 0 * * *" {
+			t.Errorf("expected 0 0 * * *, got %v", expr)
+		}
+	})
+
+	for name, expr := range map[string]string{
+		"nightly": "0 0 * * *", "@": "0 0 * * *", "@night ly": "0 0 * * *",
+		"@bad-expr": "* * *", "@bad-value": "A * * * *", "@bad-reboot": "@reboot",
+	} {
+		t.Run("register macro err "+name, func(t *testing.T) {
+			if err := RegisterMacro(name, expr); err == nil {
+				t.Errorf("expected error, got nil")
+			}
+		})
+	}
+
+	t.Run("register macro concurrent", func(t *testing.T) {
+		var wg sync.WaitGroup
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				name := "@concurrent-" + strconv.Itoa(i)
+				if err := RegisterMacro(name, "0 0 * * *"); err != nil {
+					t.Errorf("expected no error, got %v", err)
+				}
+				_, _ = gron.IsDue(name)
+				_ = Macros()
+			}(i)
+		}
+		wg.Wait()
+	})
+}
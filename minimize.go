@@ -0,0 +1,112 @@
+package gronx
+
+import (
+	"errors"
+	"strings"
+)
+
+// Minimize rewrites cron expr into a simpler one that is due at the same times, segment by
+// segment only, for readable crontabs. The values are rewritten as in Canonicalize (eg: `0-59`
+// and `*/1` become `*`, `1,2,3,4,5` becomes `1-5`, `0,7` becomes `0`) keeping the names if they
+// were written, and the values subsumed by others in list with L, W or # are dropped (eg: `1-31,L`
+// becomes `1-31`). The day of month and week day are kept restricted or not as written, so that
+// expr is due at the same times whether or not they are due either (WithDayOr), and so is the hour
+// as it is due as per DST rules if restricted (eg: `0-23` stays). The macros,
+// @every, @reboot and CRON_TZ= prefix are kept as written. It returns expr or error if it is invalid.
+func Minimize(expr string) (string, error) {
+	body, _, err := exprZone(expr)
+	if err != nil {
+		return "", err
+	}
+	body = strings.Join(strings.Fields(body), " ")
+	prefix := strings.TrimSuffix(strings.Join(strings.Fields(expr), " "), body)
+
+	if _, ok, err := parseEvery(body); ok {
+		if err != nil {
+			return "", err
+		}
+		return prefix + body, nil
+	}
+	if _, ok := macro(body); ok {
+		if _, err := Segments(body); err != nil {
+			return "", err
+		}
+		return prefix + body, nil
+	}
+
+	if err := Validate(body); err != nil {
+		return "", err
+	}
+	segs, err := Segments(body)
+	if errors.Is(err, ErrReboot) {
+		return prefix + body, nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	given, restricted := givenSegments(body, false), dayRestricted(segs)
+	out := make([]string, len(segs))
+	for pos, seg := range segs {
+		day := pos == PosDayOfMonth || pos == PosDayOfWeek
+		out[pos] = minimalSegment(seg, pos, restricted && day)
+	}
+	if !restricted && dayRestricted(out) {
+		// The day segment starting with * must stay so, eg: */27 is not 1,28.
+		for _, pos := range []int{PosDayOfMonth, PosDayOfWeek} {
+			if strings.HasPrefix(segs[pos], "*") {
+				out[pos] = starSegment(segs[pos], pos)
+			}
+		}
+	}
+	restrictHour(segs, out)
+	for pos := range out {
+		if pos < len(given) && strings.ToUpper(given[pos]) != segs[pos] {
+			out[pos] = namedSegment(out[pos], pos)
+		}
+	}
+	if len(out) > PosYear && out[PosYear] == "*" {
+		out = out[:PosYear]
+	}
+
+	return prefix + strings.Join(out, " "), nil
+}
+
+// minimalSegment gives the simpler form of segment at given position like canonicalSegment, with
+// the values of list with L, W or # simplified on their own unless they are all the values.
+// If restricted, the form doesn't start with * as in canonicalSegment.
+func minimalSegment(segment string, pos int, restricted bool) string {
+	if canonicalRe.MatchString(segment) || (pos != PosDayOfMonth && pos != PosDayOfWeek) {
+		return canonicalSegment(segment, pos, restricted)
+	}
+	if strings.HasPrefix(segment, "*") {
+		return segment
+	}
+
+	values, mods, seen := []string{}, []string{}, map[string]bool{}
+	for _, offset := range strings.Split(segment, ",") {
+		switch {
+		case seen[offset]:
+		case canonicalRe.MatchString(offset):
+			values = append(values, offset)
+		default:
+			mods = append(mods, offset)
+		}
+		seen[offset] = true
+	}
+	if len(values) == 0 {
+		return strings.Join(mods, ",")
+	}
+
+	min, max := valueBounds(pos)
+	if pos == PosDayOfWeek {
+		max = 6
+	}
+	static := strings.Join(values, ",")
+	if len(segmentValues(static, pos)) == max-min+1 {
+		// The L, W and # are due on one of the values anyway.
+		mods = nil
+	}
+
+	return strings.Join(append([]string{canonicalSegment(static, pos, restricted)}, mods...), ",")
+}
@@ -0,0 +1,127 @@
+package gronx
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMinimize(t *testing.T) {
+	tests := map[string]string{
+		"0-59 * * * *":                      "* * * * *",
+		"*/1 */1 * * *":                     "* 0-23 * * *",
+		"0 0-23 * * *":                      "0 0-23 * * *",
+		"1,2,3,4,5 * * * *":                 "1-5 * * * *",
+		"1-10,5,7 * * * *":                  "1-10 * * * *",
+		"0 0 * * 0,7":                       "0 0 * * 0",
+		"0 0 * jan,feb,mar mon,tue,wed":     "0 0 * JAN-MAR MON-WED",
+		"0  0 * * sat,sun":                  "0 0 * * SUN,SAT",
+		"0 0 1-31 * 1-5":                    "0 0 1-31 * 1-5",
+		"0 0 */27 * 1-5":                    "0 0 */27 * 1-5",
+		"0 0 1-31/2 * 0-6":                  "0 0 1-31/2 * 0-6",
+		"0 0 1-31/2 * *":                    "0 0 */2 * *",
+		"0 0 3,1,2,L,L * *":                 "0 0 1-3,L * *",
+		"0 0 1-31,15W * *":                  "0 0 * * *",
+		"0 0 1-31,15W * 1-5":                "0 0 1-31 * 1-5",
+		"0 0 1-31,15W * fri-sat,0-6":        "0 0 1-31 * SUN-SAT",
+		"0 0 * * 5L,fri,0-6":                "0 0 * * *",
+		"0 0 ? * 5#2":                       "0 0 ? * 5#2",
+		"0 0 1 1 * 2025,2024":               "0 0 1 1 * 2024-2025",
+		"0 0 1 1 * *":                       "0 0 1 1 *",
+		"@daily":                            "@daily",
+		"@reboot":                           "@reboot",
+		"@every  1h":                        "@every 1h",
+		"TZ=Asia/Tokyo  0 0 * 1-12 *":       "TZ=Asia/Tokyo 0 0 * * *",
+		"CRON_TZ=UTC 0,15,30,45 0-23 * * *": "CRON_TZ=UTC */15 0-23 * * *",
+	}
+
+	for expr, expect := range tests {
+		t.Run("minimize "+expr, func(t *testing.T) {
+			actual, err := Minimize(expr)
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if actual != expect {
+				t.Errorf("expected %s, got %s", expect, actual)
+			}
+		})
+	}
+
+	t.Run("minimize invalid", func(t *testing.T) {
+		for _, expr := range []string{"61 * * * *", "@yearlyy", "@every 1x", "TZ=Mars/Base * * * * *"} {
+			if _, err := Minimize(expr); err == nil {
+				t.Errorf("expected error for %s, got nil", expr)
+			}
+		}
+	})
+}
+
+func TestMinimizeOccurrences(t *testing.T) {
+	rnd := rand.New(rand.NewSource(94))
+	ref := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	limits := [][2]int{{0, 59}, {0, 23}, {1, 31}, {1, 12}, {0, 7}}
+	mods := [][]string{PosDayOfMonth: {"L", "LW", "15W", "L-3"}, PosDayOfWeek: {"5L", "1#2", "0#5"}}
+	gron, dayOr := New(), New(WithDayOr())
+
+	for i := 0; i < 300; i++ {
+		segs := make([]string, len(limits))
+		for pos, limit := range limits {
+			offsets := make([]string, 1+rnd.Intn(3))
+			for j := range offsets {
+				offsets[j] = randomOffset(rnd, limit[0], limit[1])
+				if pos < len(mods) && len(mods[pos]) > 0 && rnd.Intn(4) == 0 {
+					offsets[j] = mods[pos][rnd.Intn(len(mods[pos]))]
+				}
+			}
+			segs[pos] = strings.Join(offsets, ",")
+		}
+		if rnd.Intn(3) == 0 {
+			segs[PosDayOfMonth] = "*"
+		}
+
+		expr := strings.Join(segs, " ")
+		min, err := Minimize(expr)
+		if err != nil {
+			// The random ranges can wrap around where it is not allowed.
+			continue
+		}
+
+		t.Run("minimal occurrences "+expr, func(t *testing.T) {
+			if again, err := Minimize(min); err != nil || again != min {
+				t.Errorf("expected %s again, got %s (%v)", min, again, err)
+			}
+			for j := 0; j < 100; j++ {
+				at := ref.Add(time.Duration(rnd.Intn(366*24*60)) * time.Minute)
+				for _, g := range []*Gronx{&gron, &dayOr} {
+					due, err1 := g.IsDue(expr, at)
+					mdue, err2 := g.IsDue(min, at)
+					if err1 != nil || err2 != nil || due != mdue {
+						t.Fatalf("%s at %v: expected %v like %s, got %v (%v, %v)", min, at, due, expr, mdue, err1, err2)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestMinimizeDST(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	abort(err)
+	gron := New()
+	start, end := time.Date(2024, time.November, 3, 0, 0, 0, 0, loc), time.Date(2024, time.November, 3, 4, 0, 0, 0, loc)
+
+	for _, expr := range []string{"0 0-23 * * *", "*/30 0-23 * * *", "0 */1 * * *"} {
+		t.Run("minimal dst "+expr, func(t *testing.T) {
+			minimal, err := Minimize(expr)
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			count, err1 := gron.CountOccurrences(expr, start, end)
+			mcount, err2 := gron.CountOccurrences(minimal, start, end)
+			if err1 != nil || err2 != nil || count != mcount {
+				t.Errorf("%s: expected %d like %s, got %d (%v, %v)", minimal, count, expr, mcount, err1, err2)
+			}
+		})
+	}
+}
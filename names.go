@@ -0,0 +1,165 @@
+package gronx
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// namesMu guards days and months against concurrent registration.
+var namesMu sync.RWMutex
+
+var days = map[string]int{
+	"SUN": 0, "MON": 1, "TUE": 2, "WED": 3, "THU": 4, "FRI": 5, "SAT": 6,
+	"SUNDAY": 0, "MONDAY": 1, "TUESDAY": 2, "WEDNESDAY": 3, "THURSDAY": 4, "FRIDAY": 5, "SATURDAY": 6,
+}
+
+var months = map[string]int{
+	"JAN": 1, "FEB": 2, "MAR": 3, "APR": 4, "MAY": 5, "JUN": 6, "JUL": 7, "AUG": 8, "SEP": 9, "OCT": 10, "NOV": 11, "DEC": 12,
+	"JANUARY": 1, "FEBRUARY": 2, "MARCH": 3, "APRIL": 4, "JUNE": 6, "JULY": 7, "AUGUST": 8,
+	"SEPTEMBER": 9, "OCTOBER": 10, "NOVEMBER": 11, "DECEMBER": 12,
+}
+
+// nameRe is regex for names in segment.
+var nameRe = regexp.MustCompile(`\pL+`)
+
+// replaceNames replaces the names (or names followed by L) in uppercase segment with their
+// numbers, where sunday ending a range is 7. The unknown names are kept as is.
+func replaceNames(segment string, names map[string]int) string {
+	if !hasLetter(segment) {
+		return segment
+	}
+
+	namesMu.RLock()
+	defer namesMu.RUnlock()
+
+	out, prev := strings.Builder{}, 0
+	for _, loc := range nameRe.FindAllStringIndex(segment, -1) {
+		name, suffix := segment[loc[0]:loc[1]], ""
+		num, ok := names[name]
+		if !ok && strings.HasSuffix(name, "L") {
+			name, suffix = name[:len(name)-1], "L"
+			num, ok = names[name]
+		}
+		if !ok {
+			continue
+		}
+
+		if num == 0 && loc[0] > 0 && segment[loc[0]-1] == '-' {
+			num = 7
+		}
+		out.WriteString(segment[prev:loc[0]] + strconv.Itoa(num) + suffix)
+		prev = loc[1]
+	}
+	out.WriteString(segment[prev:])
+
+	return out.String()
+}
+
+// unknownName checks if there is any name left in month or week day segment after replacement.
+// The name of week day or month in any other segment (except year) is told as misplaced. Other
+// letters in minute, hour and day of month are left for the checker to tell with the list element.
+func unknownName(segment string, pos int) error {
+	if pos > PosDayOfWeek {
+		return nil
+	}
+
+	named, allowed := pos == PosMonth || pos == PosDayOfWeek, letterModifiers(pos)
+
+	for _, name := range nameRe.FindAllString(segment, -1) {
+		if allowed[name] {
+			continue
+		}
+		if field := nameField(name); field != "" {
+			err := fmt.Errorf("%s name %q is not allowed in %s segment: %s", field, name, segmentNames[pos], segment)
+			return fieldErr(pos, segment, ReasonMisplacedName, err)
+		}
+		if named {
+			err := fmt.Errorf("unknown name %q in %s segment: %s", name, segmentNames[pos], segment)
+			suggestion := nameSuggestion(name, pos)
+			if suggestion != "" {
+				err = fmt.Errorf("%w, did you mean %s?", err, suggestion)
+			}
+			return &FieldError{Pos: pos, Segment: segment, Reason: ReasonUnknownName, Err: err, Suggestion: suggestion}
+		}
+	}
+
+	return nil
+}
+
+// nameField gives the segment which name (or name followed by L) belongs to, if any.
+func nameField(name string) string {
+	namesMu.RLock()
+	defer namesMu.RUnlock()
+
+	for _, name := range []string{name, strings.TrimSuffix(name, "L")} {
+		if _, ok := months[name]; ok {
+			return segmentNames[PosMonth]
+		}
+		if _, ok := days[name]; ok {
+			return segmentNames[PosDayOfWeek]
+		}
+	}
+
+	return ""
+}
+
+// RegisterDayNames registers additional week day names like MO or LUN with their numbers (0-7,
+// where both 0 and 7 are sunday), to be used like the built-in ones. The names are case
+// insensitive. It errors without registering any if a name is invalid or already exists.
+// It is safe for concurrent use.
+func RegisterDayNames(names map[string]int) error {
+	return registerNames(days, names, 0, 7)
+}
+
+// RegisterMonthNames registers additional month names like MÄR or AVRIL with their numbers
+// (1-12), to be used like the built-in ones. The names are case insensitive. It errors
+// without registering any if a name is invalid or already exists. It is safe for concurrent use.
+func RegisterMonthNames(names map[string]int) error {
+	return registerNames(months, names, 1, 12)
+}
+
+// registerNames registers names into the given names after validating them all.
+func registerNames(into, names map[string]int, min, max int) error {
+	namesMu.Lock()
+	defer namesMu.Unlock()
+
+	upper := make(map[string]int, len(names))
+	for name, num := range names {
+		name = strings.ToUpper(name)
+		if !nameRe.MatchString(name) || nameRe.FindString(name) != name || letterModifiers(PosDayOfWeek)[name] {
+			return errors.New("name should contain only letters and not be a modifier: " + name)
+		}
+		if num < min || num > max {
+			return fmt.Errorf("number for name %s should be %d-%d: %d", name, min, max, num)
+		}
+		if _, ok := into[name]; ok {
+			return errors.New("name is already registered: " + name)
+		}
+		if _, ok := upper[name]; ok {
+			return errors.New("name is given more than once: " + name)
+		}
+		upper[name] = num
+	}
+
+	for name, num := range upper {
+		into[name] = num
+	}
+
+	return nil
+}
+
+// hasLetter checks if segment has any letter like nameRe, which names need.
+func hasLetter(segment string) bool {
+	for _, r := range segment {
+		if unicode.IsLetter(r) {
+			return true
+		}
+	}
+
+	return false
+}
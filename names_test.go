@@ -0,0 +1,151 @@
+package gronx
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestUnknownName(t *testing.T) {
+	tests := map[string]string{
+		"0 0 * * MONDAYY":     `"MONDAYY" in week day segment`,
+		"0 0 * JANUARYY *":    `"JANUARYY" in month segment`,
+		"0 0 * * MON-FRIDA":   `"FRIDA" in week day segment`,
+		"0 0 * MON *":         `week day name "MON" is not allowed in month segment`,
+		"0 0 * * JAN":         `month name "JAN" is not allowed in week day segment`,
+		"0 0 * 1-SUN *":       `week day name "SUN" is not allowed in month segment`,
+		"0 0 * * DECL":        `month name "DECL" is not allowed in week day segment`,
+		"SUN 0 * * *":         `week day name "SUN" is not allowed in minute segment`,
+		"0 JAN * * *":         `month name "JAN" is not allowed in hour segment`,
+		"0 0 FRI * *":         `week day name "FRI" is not allowed in day of month segment`,
+		"0 0 * * 1DAY":        `"DAY" in week day segment`,
+		"0 0 * JAN,FOO-MAR *": `"FOO" in month segment`,
+	}
+
+	for expr, expect := range tests {
+		t.Run("unknown name "+expr, func(t *testing.T) {
+			_, err := Segments(expr)
+			if err == nil || !strings.Contains(err.Error(), expect) {
+				t.Errorf("expected %s, got %v", expect, err)
+			}
+		})
+	}
+
+	for _, expr := range []string{"0 0 * H R", "0 0 * * 5L", "0 0 * * FRIDAYL", "0 0 * R H(1-5)", "0 0 LW * *", "0 0 15W,L * *", "H R * * *"} {
+		t.Run("known name "+expr, func(t *testing.T) {
+			if _, err := Segments(expr); err != nil {
+				t.Errorf("expected no error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestRegisterNames(t *testing.T) {
+	gron := New()
+
+	t.Run("register day names", func(t *testing.T) {
+		if err := RegisterDayNames(map[string]int{"MO": 1, "DI": 2, "MI": 3, "DO": 4, "FR": 5, "SA": 6, "SO": 0}); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if err := RegisterDayNames(map[string]int{"ПН": 1, "ПТ": 5, "ВС": 0}); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if err := RegisterDayNames(map[string]int{"lun": 1, "mar": 2, "mer": 3, "jeu": 4, "ven": 5, "sam": 6, "dim": 7}); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		tests := []Case{
+			{"0 9 * * MO-FR", "2021-04-19 09:00:00", true},
+			{"0 9 * * MO-FR", "2021-04-18 09:00:00", false},
+			{"0 9 * * lun-ven/2", "2021-04-21 09:00:00", true},
+			{"0 9 * * LUN,MER-VEN", "2021-04-20 09:00:00", false},
+			{"0 9 * * SA-SO", "2021-04-18 09:00:00", true},
+			{"0 9 * * dim", "2021-04-18 09:00:00", true},
+			{"0 9 * * FRL", "2021-04-30 09:00:00", true},
+			{"0 9 * * пн-пт", "2021-04-19 09:00:00", true},
+			{"0 9 * * ВС", "2021-04-18 09:00:00", true},
+		}
+		for _, test := range tests {
+			if actual, err := test.run(gron); err != nil || actual != test.Expect {
+				t.Errorf("expected %v for %s, got %v, %v", test.Expect, test.Expr, actual, err)
+			}
+		}
+
+		iso := New(WithISOWeekday())
+		tests = []Case{
+			{"0 9 * * SO", "2021-04-18 09:00:00", true},
+			{"0 9 * * so", "2021-04-19 09:00:00", false},
+			{"0 9 * * SA-SO", "2021-04-18 09:00:00", true},
+			{"0 9 * * SOL", "2021-04-25 09:00:00", true},
+			{"0 9 * * SO#3", "2021-04-18 09:00:00", true},
+			{"0 9 * * dim", "2021-04-18 09:00:00", true},
+			{"0 9 * * ВС", "2021-04-18 09:00:00", true},
+			{"0 9 * * ПТ-ВС", "2021-04-18 09:00:00", true},
+			{"0 9 * * ВСL", "2021-04-25 09:00:00", true},
+		}
+		for _, test := range tests {
+			if actual, err := test.run(iso); err != nil || actual != test.Expect {
+				t.Errorf("expected %v for %s in ISO mode, got %v, %v", test.Expect, test.Expr, actual, err)
+			}
+		}
+	})
+
+	t.Run("register month names", func(t *testing.T) {
+		if err := RegisterMonthNames(map[string]int{"MÄR": 3, "MAI": 5, "Okt": 10, "DEZ": 12, "AVRIL": 4}); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		tests := []Case{
+			{"0 0 1 mär-mai * *", "2021-04-01 00:00:00", true},
+			{"0 0 1 OKT-DEZ/2 *", "2021-12-01 00:00:00", true},
+			{"0 0 1 OKT-DEZ/2 *", "2021-11-01 00:00:00", false},
+			{"0 0 1 avril *", "2021-04-01 00:00:00", true},
+		}
+		for _, test := range tests {
+			if actual, err := test.run(gron); err != nil || actual != test.Expect {
+				t.Errorf("expected %v for %s, got %v, %v", test.Expect, test.Expr, actual, err)
+			}
+		}
+		if _, err := Segments("0 0 1 * MAI"); err == nil {
+			t.Errorf("expected error for month name in week day segment, got nil")
+		}
+	})
+
+	errs := []map[string]int{
+		{"MON": 1}, {"mo": 1}, {"ZZ": 8}, {"ZZ": -1}, {"Z1": 1}, {"L": 5}, {"H": 1}, {"": 1}, {"YY": 1, "yy": 2},
+	}
+	for _, names := range errs {
+		t.Run("register day names err", func(t *testing.T) {
+			if err := RegisterDayNames(names); err == nil {
+				t.Errorf("expected error for %v, got nil", names)
+			}
+		})
+	}
+
+	t.Run("register names atomic", func(t *testing.T) {
+		if err := RegisterMonthNames(map[string]int{"XA": 1, "JAN": 1}); err == nil {
+			t.Errorf("expected error, got nil")
+		}
+		if _, err := Segments("0 0 1 XA *"); err == nil {
+			t.Errorf("expected XA not to be registered")
+		}
+		if err := RegisterMonthNames(map[string]int{"XB": 13}); err == nil {
+			t.Errorf("expected error, got nil")
+		}
+	})
+
+	t.Run("register names concurrent", func(t *testing.T) {
+		done := make(chan bool)
+		for _, name := range []string{"QA", "QB", "QC", "QD"} {
+			go func(name string) {
+				if err := RegisterMonthNames(map[string]int{name: 1}); err != nil {
+					t.Errorf("expected no error, got %v", err)
+				}
+				_, _ = Segments("0 0 1 " + name + " *")
+				done <- true
+			}(name)
+		}
+		for i := 0; i < 4; i++ {
+			<-done
+		}
+	})
+}
@@ -0,0 +1,33 @@
+package gronx
+
+import "time"
+
+// NearestRun gets whichever of the previous (on or before) and next (after) due times of given
+// cron expr is closer to t, along with the absolute distance from t. Exact ties go to the
+// previous one. If only one side exists within the search years, that one is given.
+func (g *Gronx) NearestRun(expr string, t time.Time) (time.Time, time.Duration, error) {
+	e, err := g.Parse(expr)
+	if err != nil {
+		return time.Time{}, 0, err
+	}
+	t, check := inZone(t, e.loc), g.check(e)
+
+	prev, hasPrev, err := e.prevUntil(t, g.horizon(t, true), check)
+	if err != nil {
+		return time.Time{}, 0, err
+	}
+
+	next, hasNext, err := e.nextUntil(e.slot(t).Add(e.span()), g.horizon(t, false), check)
+	if err != nil {
+		return time.Time{}, 0, err
+	}
+
+	switch {
+	case hasPrev && (!hasNext || t.Sub(prev) <= next.Sub(t)):
+		return prev, t.Sub(prev), nil
+	case hasNext:
+		return next, next.Sub(t), nil
+	}
+
+	return time.Time{}, 0, &SearchError{Expr: e.expr, Ref: t, Year: g.horizon(t, false).Year()}
+}
@@ -0,0 +1,64 @@
+package gronx
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestNearestRun(t *testing.T) {
+	gron := New()
+	tests := []struct {
+		expr, ref, expect string
+		dist              time.Duration
+	}{
+		{"0 * * * *", "2021-04-19 10:00:00", "2021-04-19 10:00:00", 0},
+		{"0 * * * *", "2021-04-19 10:29:59", "2021-04-19 10:00:00", 29*time.Minute + 59*time.Second},
+		{"0 * * * *", "2021-04-19 10:30:00", "2021-04-19 10:00:00", 30 * time.Minute},
+		{"0 * * * *", "2021-04-19 10:30:01", "2021-04-19 11:00:00", 29*time.Minute + 59*time.Second},
+		{"0 0 1 1 * 2030", "2021-04-19 10:00:00", "2030-01-01 00:00:00", 0},
+		{"0 0 1 1 * 2020", "2021-04-19 10:00:00", "2020-01-01 00:00:00", 0},
+	}
+
+	for _, test := range tests {
+		t.Run("nearest run "+test.expr+" "+test.ref, func(t *testing.T) {
+			ref, _ := time.Parse(dateFormat, test.ref)
+			expect, _ := time.Parse(dateFormat, test.expect)
+			if test.dist == 0 {
+				test.dist = ref.Sub(expect)
+				if test.dist < 0 {
+					test.dist = -test.dist
+				}
+			}
+
+			actual, dist, err := gron.NearestRun(test.expr, ref)
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if !actual.Equal(expect) || dist != test.dist {
+				t.Errorf("expected %v by %v, got %v by %v", expect, test.dist, actual, dist)
+			}
+		})
+	}
+
+	t.Run("nearest run seconds", func(t *testing.T) {
+		ref := time.Date(2021, 4, 19, 10, 0, 7, 0, time.UTC)
+		gron := New(WithSecondsField())
+		actual, dist, err := gron.NearestRun("*/10 * * * * *", ref)
+		if expect := ref.Add(3 * time.Second); err != nil || !actual.Equal(expect) || dist != 3*time.Second {
+			t.Errorf("expected %v by 3s, got %v by %v, %v", expect, actual, dist, err)
+		}
+	})
+
+	t.Run("nearest run none", func(t *testing.T) {
+		if _, _, err := gron.NearestRun("0 0 30 2 *", time.Now()); !errors.Is(err, ErrNoOccurrence) {
+			t.Errorf("expected ErrNoOccurrence, got %v", err)
+		}
+	})
+
+	t.Run("nearest run invalid", func(t *testing.T) {
+		if _, _, err := gron.NearestRun("* * *", time.Now()); err == nil {
+			t.Errorf("expected error, got nil")
+		}
+	})
+}
@@ -0,0 +1,271 @@
+package gronx
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// searchLimit gives the time until which due time is searched from ref within years, the
+// start of earliest year if prev is true or else the end of latest year.
+func searchLimit(ref time.Time, years yearRange, prev bool) time.Time {
+	if prev {
+		return time.Date(years.min, time.January, 1, 0, 0, 0, 0, ref.Location())
+	}
+
+	return time.Date(years.max+1, time.January, 1, 0, 0, 0, 0, ref.Location()).Add(-time.Nanosecond)
+}
+
+// checkOrder is the order of segment positions from most to least significant.
+var checkOrder = []int{PosYear, PosMonth, PosDayOfMonth, PosDayOfWeek, PosHour, PosMinute, PosSecond}
+
+// GetNext gets the next due time for given cron expr on or after reference time (or now).
+// The seconds and nanoseconds are stripped off, except seconds of sub-minute @every interval
+// and of seconds field if enabled.
+// It returns time or error if any.
+func (g *Gronx) GetNext(expr string, ref ...time.Time) (*time.Time, error) {
+	e, err := g.Parse(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	if len(ref) > 0 {
+		start = ref[0]
+	}
+	next, err := e.next(start, g.check(e))
+	if err != nil {
+		return nil, err
+	}
+
+	return &next, nil
+}
+
+// GetNextBefore gets the next due time for given cron expr on or after reference time,
+// only if it is on or before until. It returns ErrNoOccurrence otherwise, so the search
+// never goes beyond until.
+func (g *Gronx) GetNextBefore(expr string, ref, until time.Time) (*time.Time, error) {
+	e, err := g.Parse(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	next, ok, err := e.nextUntil(e.nextRef(ref), until, g.check(e))
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNoOccurrence
+	}
+
+	return &next, nil
+}
+
+// dueFunc checks if the segment at given position is due for ref.
+type dueFunc func(segment string, pos int, ref time.Time) (bool, error)
+
+// due checks if the segment at given position is due for ref using the checker.
+func (g *Gronx) due(segment string, pos int, ref time.Time) (bool, error) {
+	return g.C.CheckDue(segment, pos, ref)
+}
+
+// dueFor gives the func to check if segment of segs is due, where day of month and week day
+// are due if either of them is due when WithDayOr applies to segs.
+func (g *Gronx) dueFor(segs []string) dueFunc {
+	return dayOrFor(segs, g.dayOr, g.due)
+}
+
+// dayOrFor gives the func to check if segment of segs is due as per due, where day of month
+// and week day are due if either of them is due when dayOr is true and both are restricted.
+func dayOrFor(segs []string, dayOr bool, due dueFunc) dueFunc {
+	if !dayOr || !dayRestricted(segs) {
+		return due
+	}
+
+	return func(segment string, pos int, ref time.Time) (bool, error) {
+		switch pos {
+		case PosDayOfMonth:
+			if ok, err := due(segment, pos, ref); ok || err != nil {
+				return ok, err
+			}
+			return due(segs[PosDayOfWeek], PosDayOfWeek, ref)
+		case PosDayOfWeek:
+			// Checked along with day of month.
+			return true, nil
+		}
+
+		return due(segment, pos, ref)
+	}
+}
+
+// nextTime finds the next due time for segments on or before limit by bumping the most
+// significant segment that is not due to the start of its next period, instead of minute
+// by minute. It returns the time, whether it was found and error if any.
+func nextTime(segs []string, ref, limit time.Time, due dueFunc) (time.Time, bool, error) {
+	step := tick(segs)
+	next := truncTick(ref, step)
+	if len(segs) > 5 {
+		// Jump to the earliest year, or fail fast if the latest year is already past.
+		lo, hi, ok, _ := yearSpan(segs[5], anyYears)
+		if ok && (hi < next.Year() || lo > limit.Year()) {
+			return limit, false, nil
+		}
+		if ok && next.Year() < lo {
+			next = dayStart(lo, time.January, 1, next.Location())
+		}
+	}
+	// Fail fast if no day can ever be due, eg: 31st of February.
+	if fire, err := willFire(segs, due, yearRange{next.Year(), limit.Year()}); !fire || err != nil {
+		return limit, false, err
+	}
+
+	for !next.After(limit) {
+		pos, err := undueSegment(segs, next, due)
+		if err != nil {
+			return next, false, err
+		}
+		if pos < 0 && !repeatedWall(segs, next) {
+			return next, true, nil
+		}
+		if pos < 0 {
+			// The wall clock times repeated by DST fall-back are due only the first time.
+			next = next.Add(step)
+			continue
+		}
+		if ok, err := gapDue(segs, next, step, due); ok || err != nil {
+			return next, ok, err
+		}
+		if pos == PosDayOfMonth && shortMonth(segs, next) {
+			pos = PosMonth
+		}
+
+		bumped, err := bumpNext(next, pos, step)
+		if err != nil {
+			return bumped, false, err
+		}
+		if !bumped.After(next) {
+			return limit, false, &SearchError{Expr: strings.Join(segs, " "), Ref: ref, Year: next.Year()}
+		}
+		// The wall clock times skipped by DST gap are due at the end of it.
+		if gap, ok := gapBetween(next, bumped, step); ok && !gap.After(limit) {
+			if ok, err := gapDue(segs, gap, step, due); ok || err != nil {
+				return gap, ok, err
+			}
+		}
+		next = bumped
+	}
+
+	return limit, false, nil
+}
+
+// truncMinute strips off seconds and nanoseconds from ref keeping its zone offset intact.
+func truncMinute(ref time.Time) time.Time {
+	return ref.Add(-time.Duration(ref.Second())*time.Second - time.Duration(ref.Nanosecond()))
+}
+
+// tick gives the smallest unit of time segments can be due for, ie second if they have it.
+func tick(segs []string) time.Duration {
+	if len(segs) > PosSecond {
+		return time.Second
+	}
+
+	return time.Minute
+}
+
+// truncTick strips off the part of ref smaller than step, which is either minute or second.
+func truncTick(ref time.Time, step time.Duration) time.Time {
+	if step == time.Second {
+		return ref.Add(-time.Duration(ref.Nanosecond()))
+	}
+
+	return truncMinute(ref)
+}
+
+// undueSegment finds the most significant segment that is not due for ref.
+// It returns the segment position (or -1 if all are due) and error if any.
+func undueSegment(segs []string, ref time.Time, due dueFunc) (int, error) {
+	for _, pos := range checkOrder {
+		if pos >= len(segs) || segs[pos] == "*" || segs[pos] == "?" {
+			continue
+		}
+
+		if ok, err := due(segs[pos], pos, ref); !ok || err != nil {
+			return pos, err
+		}
+	}
+
+	return -1, nil
+}
+
+// bumpNext moves ref to the start of next period of the segment at given position, where
+// step is the smallest unit of time to move by. It returns error if the position is unknown.
+func bumpNext(ref time.Time, pos int, step time.Duration) (time.Time, error) {
+	loc := ref.Location()
+	switch pos {
+	case PosYear:
+		return dayStart(ref.Year()+1, time.January, 1, loc), nil
+	case PosMonth:
+		return dayStart(ref.Year(), ref.Month()+1, 1, loc), nil
+	case PosDayOfMonth, PosDayOfWeek:
+		return dayStart(ref.Year(), ref.Month(), ref.Day()+1, loc), nil
+	case PosHour:
+		return truncMinute(ref).Add(time.Duration(60-ref.Minute()) * time.Minute), nil
+	case PosMinute:
+		return truncMinute(ref).Add(time.Minute), nil
+	case PosSecond:
+		return ref.Add(step), nil
+	}
+
+	return ref, posErr(pos)
+}
+
+// GetNextN gets the next n due times for given cron expr on or after reference time,
+// in ascending order with each strictly after the previous one. If fewer than n due
+// times exist within the search horizon, it returns those found along with error.
+func (g *Gronx) GetNextN(expr string, ref time.Time, n int) ([]time.Time, error) {
+	e, err := g.Parse(expr)
+	if err != nil {
+		return nil, err
+	}
+	if n < 1 {
+		return []time.Time{}, nil
+	}
+
+	times, ref, check := make([]time.Time, 0, n), e.nextRef(inZone(ref, e.loc)), g.check(e)
+	for len(times) < n {
+		limit := g.horizon(ref, false)
+		next, ok, err := e.nextUntil(ref, limit, check)
+		if err != nil {
+			return times, err
+		}
+		if !ok {
+			serr := &SearchError{Expr: e.expr, Ref: ref, Year: limit.Year()}
+			return times, fmt.Errorf("found only %d of %d next due times: %w", len(times), n, serr)
+		}
+
+		times = append(times, next)
+		ref = next.Add(e.span())
+	}
+
+	return times, nil
+}
+
+// TimeUntilNext gets the duration from reference time (or now) until the next due time of
+// given cron expr. It is 0 if the reference time itself is due. It returns error if the
+// expr is invalid or can't be due again.
+func (g *Gronx) TimeUntilNext(expr string, ref ...time.Time) (time.Duration, error) {
+	start := time.Now()
+	if len(ref) > 0 {
+		start = ref[0]
+	}
+
+	next, err := g.GetNext(expr, start)
+	if err != nil {
+		return 0, err
+	}
+	if !next.After(start) {
+		return 0, nil
+	}
+
+	return next.Sub(start), nil
+}
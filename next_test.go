@@ -0,0 +1,322 @@
+package gronx
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+type NextCase struct {
+	Expr   string
+	Ref    string
+	Expect string
+}
+
+func (test NextCase) ref() time.Time {
+	ref, err := time.Parse(dateFormat, test.Ref)
+	abort(err)
+
+	return ref
+}
+
+const dateFormat = "2006-01-02 15:04:05"
+
+func TestGetNext(t *testing.T) {
+	gron := New()
+
+	for _, test := range nextcases() {
+		t.Run("get next "+test.Expr+" "+test.Ref, func(t *testing.T) {
+			next, err := gron.GetNext(test.Expr, test.ref())
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			if actual := next.Format(dateFormat); actual != test.Expect {
+				t.Errorf("expected %v, got %v", test.Expect, actual)
+			}
+		})
+	}
+
+	for _, test := range nexterrcases() {
+		t.Run("get next err "+test.Expr, func(t *testing.T) {
+			next, err := gron.GetNext(test.Expr, test.ref())
+			if err == nil {
+				t.Errorf("expected error, got %v", next)
+			}
+			if next != nil {
+				t.Errorf("expected nil, got %v", next)
+			}
+		})
+	}
+
+	t.Run("get next now", func(t *testing.T) {
+		next, err := gron.GetNext("@always")
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if next.Second() != 0 || next.Nanosecond() != 0 {
+			t.Errorf("expected seconds stripped, got %v", next)
+		}
+	})
+}
+
+func nextcases() []NextCase {
+	return []NextCase{
+		{"* * * * *", "2021-04-19 12:54:09", "2021-04-19 12:54:00"},
+		{"@always", "2021-04-19 12:54:59", "2021-04-19 12:54:00"},
+		{"*/5 * * * *", "2021-04-19 12:56:00", "2021-04-19 13:00:00"},
+		{"0 * * * *", "2021-04-19 23:01:00", "2021-04-20 00:00:00"},
+		{"@daily", "2021-04-30 00:01:00", "2021-05-01 00:00:00"},
+		{"@hourly", "2021-12-31 23:30:00", "2022-01-01 00:00:00"},
+		{"@yearly", "2021-01-01 00:01:00", "2022-01-01 00:00:00"},
+		{"@quarterly", "2021-01-01 00:01:00", "2021-04-01 00:00:00"},
+		{"@quarterly", "2021-04-01 00:01:00", "2021-07-01 00:00:00"},
+		{"@quarterly", "2021-07-15 00:00:00", "2021-10-01 00:00:00"},
+		{"@quarterly", "2021-10-01 00:01:00", "2022-01-01 00:00:00"},
+		{"@weekend", "2021-04-19 00:00:00", "2021-04-24 00:00:00"},
+		{"0 0 * * 7", "2021-04-19 00:00:00", "2021-04-25 00:00:00"},
+		{"0 22-2 * * *", "2021-04-19 02:01:00", "2021-04-19 22:00:00"},
+		{"5/10 * * * *", "2021-04-19 10:56:00", "2021-04-19 11:05:00"},
+		{"0 0 1 1 * 2025-2030", "2021-04-19 00:00:00", "2025-01-01 00:00:00"},
+		{"0 0 1 1 * 2025,2027,2031", "2027-01-01 00:01:00", "2031-01-01 00:00:00"},
+		{"0 0 1 1 * 2024/2", "2025-01-01 00:00:00", "2026-01-01 00:00:00"},
+		{"0 0 1 1 * */5", "2021-01-01 00:00:00", "2025-01-01 00:00:00"},
+		{"0 0 1 1 * 2030-2040/5", "2031-01-01 00:00:00", "2035-01-01 00:00:00"},
+		{"0 0 1 1 * *", "2021-01-01 00:01:00", "2022-01-01 00:00:00"},
+		{"0 22-2 * * *", "2021-04-19 23:01:00", "2021-04-20 00:00:00"},
+		{"0 9 * * FRI-MON", "2021-04-20 00:00:00", "2021-04-23 09:00:00"},
+		{"@weekday", "2021-04-17 00:00:00", "2021-04-19 00:00:00"},
+		{"30 9 * * MON", "2021-04-19 09:31:00", "2021-04-26 09:30:00"},
+		{"0 0 31 * *", "2021-01-31 00:01:00", "2021-03-31 00:00:00"},
+		{"0 0 29 2 *", "2021-03-01 00:00:00", "2024-02-29 00:00:00"},
+		{"0 0 L * *", "2024-02-01 00:00:00", "2024-02-29 00:00:00"},
+		{"0 0 L * *", "2023-02-01 00:00:00", "2023-02-28 00:00:00"},
+		{"0 0 L * *", "2021-04-30 00:01:00", "2021-05-31 00:00:00"},
+		{"0 0 L 2 *", "2098-03-01 00:00:00", "2099-02-28 00:00:00"},
+		{"0 0 * * 5L", "2021-04-01 00:00:00", "2021-04-30 00:00:00"},
+		{"0 18 * * FRIL", "2021-04-30 18:01:00", "2021-05-28 18:00:00"},
+		{"0 18 * * 7L", "2021-12-27 00:00:00", "2022-01-30 18:00:00"},
+		{"0 0 * * 1#2", "2021-04-13 00:00:00", "2021-05-10 00:00:00"},
+		{"0 9 * * MON#2", "2021-06-01 00:00:00", "2021-06-14 09:00:00"},
+		{"0 9 * * MON#5", "2021-06-01 00:00:00", "2021-08-30 09:00:00"},
+		{"0 0 15W * *", "2021-04-16 00:00:00", "2021-05-14 00:00:00"},
+		{"0 0 1W * *", "2021-04-02 00:00:00", "2021-05-03 00:00:00"},
+		{"0 17 LW * *", "2021-10-29 17:01:00", "2021-11-30 17:00:00"},
+		{"0 17 LW * *", "2026-02-01 00:00:00", "2026-02-27 17:00:00"},
+		{"0 0 L-3 * *", "2021-01-28 00:01:00", "2021-02-25 00:00:00"},
+		{"0 0 L-29 * *", "2021-01-03 00:00:00", "2021-03-02 00:00:00"},
+		{"0 0 1 1 * 2030", "2021-04-19 12:54:09", "2030-01-01 00:00:00"},
+		{"0 12 ? * *", "2021-04-19 12:01:00", "2021-04-20 12:00:00"},
+	}
+}
+
+func nexterrcases() []NextCase {
+	return []NextCase{
+		{"* * * *", "2021-04-19 12:54:09", ""},
+		{"A-B * * * *", "2021-04-19 12:54:09", ""},
+		{"* * * * * 2018", "2021-04-19 12:54:09", ""},
+		{"0 0 30 2 *", "2021-04-19 12:54:09", ""},
+	}
+}
+
+func TestGetNextYears(t *testing.T) {
+	gron := New(WithSearchYears(1000))
+	ref, _ := time.Parse(dateFormat, "2050-01-01 00:00:00")
+
+	for _, expr := range []string{"0 0 1 1 * 2020", "0 0 1 1 * 2020-2049", "0 0 1 1 * 2030,2040-2045/5"} {
+		t.Run("next years past "+expr, func(t *testing.T) {
+			_, err := gron.GetNext(expr, ref)
+
+			var serr *SearchError
+			if !errors.As(err, &serr) {
+				t.Errorf("expected SearchError, got %v", err)
+			}
+		})
+	}
+
+	t.Run("prev years future", func(t *testing.T) {
+		_, err := gron.GetPrev("0 0 1 1 * 2051,2099", ref)
+
+		var serr *SearchError
+		if !errors.As(err, &serr) || !serr.Prev {
+			t.Errorf("expected SearchError, got %v", err)
+		}
+	})
+}
+
+func TestGetNextN(t *testing.T) {
+	gron := New()
+
+	t.Run("get next n", func(t *testing.T) {
+		ref, _ := time.Parse(dateFormat, "2021-04-19 12:54:09")
+		times, err := gron.GetNextN("*/15 * * * *", ref, 4)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		expect := []string{"2021-04-19 13:00:00", "2021-04-19 13:15:00", "2021-04-19 13:30:00", "2021-04-19 13:45:00"}
+		if len(times) != len(expect) {
+			t.Fatalf("expected %d times, got %d", len(expect), len(times))
+		}
+		for i, next := range times {
+			if actual := next.Format(dateFormat); actual != expect[i] {
+				t.Errorf("expected %v, got %v", expect[i], actual)
+			}
+		}
+	})
+
+	t.Run("get next n strictly ascending", func(t *testing.T) {
+		ref, _ := time.Parse(dateFormat, "2021-04-19 12:54:09")
+		times, err := gron.GetNextN("* * * * *", ref, 1000)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if len(times) != 1000 {
+			t.Fatalf("expected 1000 times, got %d", len(times))
+		}
+		for i := 1; i < len(times); i++ {
+			if !times[i].After(times[i-1]) {
+				t.Errorf("expected %v after %v", times[i], times[i-1])
+			}
+		}
+	})
+
+	t.Run("get next n exhausted", func(t *testing.T) {
+		ref, _ := time.Parse(dateFormat, "2024-12-31 22:00:00")
+		times, err := gron.GetNextN("0 * * * * 2024", ref, 5)
+		if err == nil {
+			t.Errorf("expected error, got nil")
+		}
+		if len(times) != 2 {
+			t.Errorf("expected 2 times, got %d", len(times))
+		}
+	})
+
+	t.Run("get next n invalid", func(t *testing.T) {
+		if _, err := gron.GetNextN("* * *", time.Now(), 5); err == nil {
+			t.Errorf("expected error, got nil")
+		}
+	})
+
+	t.Run("get next n zero", func(t *testing.T) {
+		if times, err := gron.GetNextN("* * * * *", time.Now(), 0); err != nil || len(times) != 0 {
+			t.Errorf("expected no times and no error, got %v, %v", times, err)
+		}
+	})
+}
+
+func TestTimeUntilNext(t *testing.T) {
+	gron := New()
+	tests := []struct {
+		expr, ref string
+		expect    time.Duration
+	}{
+		{"5 10 * * *", "2021-04-19 10:04:37", 23 * time.Second},
+		{"5 10 * * *", "2021-04-19 10:05:37", 0},
+		{"5 10 * * *", "2021-04-19 10:05:00", 0},
+		{"0 * * * *", "2021-04-19 10:05:00", 55 * time.Minute},
+		{"0 0 1 1 *", "2021-12-31 23:00:00", time.Hour},
+	}
+
+	for _, test := range tests {
+		t.Run("time until next "+test.expr+" "+test.ref, func(t *testing.T) {
+			ref, _ := time.Parse(dateFormat, test.ref)
+			actual, err := gron.TimeUntilNext(test.expr, ref)
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if actual != test.expect {
+				t.Errorf("expected %v, got %v", test.expect, actual)
+			}
+		})
+	}
+
+	t.Run("time until next now", func(t *testing.T) {
+		if actual, err := gron.TimeUntilNext("@hourly"); err != nil || actual < 0 || actual > time.Hour {
+			t.Errorf("expected duration within an hour, got %v, %v", actual, err)
+		}
+	})
+
+	for _, expr := range []string{"* * *", "* * * * * 2018", "0 0 30 2 *"} {
+		t.Run("time until next err "+expr, func(t *testing.T) {
+			if _, err := gron.TimeUntilNext(expr); err == nil {
+				t.Errorf("expected error, got nil")
+			}
+		})
+	}
+}
+
+func TestGetNextBefore(t *testing.T) {
+	gron := New()
+	tests := []struct {
+		expr, ref, until, expect string
+	}{
+		{"0 * * * *", "2021-04-19 10:30:00", "2021-04-19 11:00:00", "2021-04-19 11:00:00"},
+		{"0 * * * *", "2021-04-19 10:30:00", "2021-04-19 10:59:59", ""},
+		{"0 0 29 2 *", "2021-03-01 00:00:00", "2024-02-29 00:00:00", "2024-02-29 00:00:00"},
+		{"0 0 29 2 *", "2021-03-01 00:00:00", "2024-02-28 23:59:00", ""},
+		{"0 0 30 2 *", "2021-03-01 00:00:00", "2021-03-02 00:00:00", ""},
+	}
+
+	for _, test := range tests {
+		t.Run("get next before "+test.expr+" "+test.until, func(t *testing.T) {
+			ref, _ := time.Parse(dateFormat, test.ref)
+			until, _ := time.Parse(dateFormat, test.until)
+
+			next, err := gron.GetNextBefore(test.expr, ref, until)
+			if test.expect == "" {
+				if err != ErrNoOccurrence || next != nil {
+					t.Errorf("expected ErrNoOccurrence, got %v, %v", next, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if actual := next.Format(dateFormat); actual != test.expect {
+				t.Errorf("expected %v, got %v", test.expect, actual)
+			}
+		})
+	}
+
+	t.Run("get next before invalid", func(t *testing.T) {
+		if _, err := gron.GetNextBefore("* * *", time.Now(), time.Now()); err == nil || err == ErrNoOccurrence {
+			t.Errorf("expected parse error, got %v", err)
+		}
+	})
+}
+
+func TestGetNextUnsatisfiable(t *testing.T) {
+	gron := New()
+	ref, _ := time.Parse(dateFormat, "2025-03-10 00:00:00")
+	for _, expr := range []string{"0 0 31 2 *", "0 0 31 4,6,9,11 *", "0 0 29 2 * 2025"} {
+		t.Run("next unsatisfiable "+expr, func(t *testing.T) {
+			if _, err := gron.GetNext(expr, ref); !errors.Is(err, ErrNoOccurrence) {
+				t.Errorf("expected ErrNoOccurrence, got %v", err)
+			}
+			if _, err := gron.GetNextN(expr, ref, 2); !errors.Is(err, ErrNoOccurrence) {
+				t.Errorf("expected ErrNoOccurrence, got %v", err)
+			}
+		})
+	}
+
+	t.Run("next unsatisfiable seconds", func(t *testing.T) {
+		secs := New(WithSecondsField())
+		if _, err := secs.GetNext("30 0 0 30 2 *", ref); !errors.Is(err, ErrNoOccurrence) {
+			t.Errorf("expected ErrNoOccurrence, got %v", err)
+		}
+	})
+}
+
+func BenchmarkGetNextUnsatisfiable(b *testing.B) {
+	gron := New()
+	ref, _ := time.Parse(dateFormat, "2025-03-10 00:00:00")
+	for _, expr := range []string{"0 0 31 2 *", "0 0 29 2 * 2025"} {
+		b.Run(expr, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_, _ = gron.GetNext(expr, ref)
+			}
+		})
+	}
+}
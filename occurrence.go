@@ -0,0 +1,202 @@
+package gronx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// MaxOccurrences is the maximum number of due times OccurrencesBetween collects.
+const MaxOccurrences = 100000
+
+// OccurrencesBetween gets all the due times for given cron expr between start and end
+// (both inclusive) in ascending order. Only minute (or second, as per expr) boundaries
+// within the window count, so a start past the boundary excludes its own minute (or
+// second). If there are more than MaxOccurrences due times, it returns those collected so
+// far along with error.
+func (g *Gronx) OccurrencesBetween(expr string, start, end time.Time) ([]time.Time, error) {
+	e, err := g.Parse(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	return g.occurrences(e, ceilTick(inZone(start, e.loc), e.span()), end)
+}
+
+// MissedRuns gets all the due times for given cron expr strictly after lastRun and on or
+// before now in ascending order. Like OccurrencesBetween, it returns those collected so
+// far along with error if there are more than MaxOccurrences due times.
+func (g *Gronx) MissedRuns(expr string, lastRun, now time.Time) ([]time.Time, error) {
+	e, err := g.Parse(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	return g.occurrences(e, e.slot(lastRun).Add(e.span()), now)
+}
+
+// occurrences collects due times of e from start (on minute or second boundary) until end.
+func (g *Gronx) occurrences(e *Expression, start, end time.Time) ([]time.Time, error) {
+	times, step, check := []time.Time{}, e.span(), g.check(e)
+	for next := start; !next.After(end); next = next.Add(step) {
+		due, ok, err := e.nextUntil(next, end, check)
+		if err != nil || !ok {
+			return times, err
+		}
+
+		if len(times) == MaxOccurrences {
+			return times, fmt.Errorf("more than %d due times between %s and %s", MaxOccurrences, start, end)
+		}
+		times, next = append(times, due), due
+	}
+
+	return times, nil
+}
+
+// IsDueBetween checks if cron expr is due at any minute (or second, as per expr) boundary
+// between start and end (both inclusive). It bails as soon as the first due time is found
+// and errors if end is before start.
+func (g *Gronx) IsDueBetween(expr string, start, end time.Time) (bool, error) {
+	e, err := g.Parse(expr)
+	if err != nil {
+		return false, err
+	}
+
+	if end.Before(start) {
+		return false, errors.New("end should not be before start")
+	}
+
+	_, ok, err := e.nextUntil(ceilTick(inZone(start, e.loc), e.span()), end, g.check(e))
+
+	return ok, err
+}
+
+// CountOccurrences counts the due times for given cron expr between start and end (both
+// inclusive) by jumping from one due time to the next without collecting them. A minute
+// step like */5 with all other segments as wildcard is counted arithmetically instead.
+func (g *Gronx) CountOccurrences(expr string, start, end time.Time) (int, error) {
+	e, err := g.Parse(expr)
+	if err != nil {
+		return 0, err
+	}
+
+	step := e.span()
+	start, end = ceilTick(inZone(start, e.loc), step), inZone(end, e.loc)
+	if minutes, ok := minuteStep(e.segs); ok && step == time.Minute && wholeHourOffset(start) && wholeHourOffset(end) {
+		return countSteps(start, end, minutes), nil
+	}
+
+	count, check := 0, g.check(e)
+	for next := start; !next.After(end); next = next.Add(step) {
+		var ok bool
+		if next, ok, err = e.nextUntil(next, end, check); err != nil || !ok {
+			return count, err
+		}
+		count++
+	}
+
+	return count, nil
+}
+
+// minuteStep gets the step of minute segment if all other segments are wildcard.
+func minuteStep(segs []string) (int, bool) {
+	if len(segs) == 0 {
+		return 0, false
+	}
+	for _, seg := range segs[1:] {
+		if seg != "*" && seg != "?" {
+			return 0, false
+		}
+	}
+
+	if segs[0] == "*" {
+		return 1, true
+	}
+	if !strings.HasPrefix(segs[0], "*/") {
+		return 0, false
+	}
+
+	step, err := strconv.Atoi(segs[0][2:])
+	if err != nil || step < 1 {
+		return 0, false
+	}
+
+	return step, true
+}
+
+// countSteps counts minute boundaries between start and end whose minute is a multiple of step.
+// The start must be on minute boundary and the zone offset must not shift minutes in between.
+func countSteps(start, end time.Time, step int) int {
+	if end.Before(start) {
+		return 0
+	}
+
+	total, first := int(end.Sub(start)/time.Minute)+1, start.Minute()
+	count := total / 60 * (59/step + 1)
+	for i := 0; i < total%60; i++ {
+		if (first+i)%60%step == 0 {
+			count++
+		}
+	}
+
+	return count
+}
+
+// wholeHourOffset checks if the zone offset of ref is in whole hours.
+func wholeHourOffset(ref time.Time) bool {
+	_, offset := ref.Zone()
+
+	return offset%3600 == 0
+}
+
+// WalkOccurrences calls fn for each due time of given cron expr between start and end (both
+// inclusive) in ascending order, using constant memory. It stops when fn returns error,
+// which is then returned, or when ctx is done in which case ctx.Err() is returned. The ctx
+// is checked for every due time and at least once per simulated day.
+func (g *Gronx) WalkOccurrences(ctx context.Context, expr string, start, end time.Time, fn func(time.Time) error) error {
+	e, err := g.Parse(expr)
+	if err != nil {
+		return err
+	}
+
+	step, check := e.span(), g.check(e)
+	end = inZone(end, e.loc)
+	for next := ceilTick(inZone(start, e.loc), step); !next.After(end); {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		limit := next.Add(24 * time.Hour)
+		if limit.After(end) {
+			limit = end
+		}
+
+		due, ok, err := e.nextUntil(next, limit, check)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			next = e.slot(limit).Add(step)
+			continue
+		}
+
+		if err := fn(due); err != nil {
+			return err
+		}
+		next = due.Add(step)
+	}
+
+	return nil
+}
+
+// ceilTick rounds ref up to the boundary of step, ie minute or second, unless it is already on one.
+func ceilTick(ref time.Time, step time.Duration) time.Time {
+	if next := truncTick(ref, step); next.Before(ref) {
+		return next.Add(step)
+	}
+
+	return ref
+}
@@ -0,0 +1,390 @@
+package gronx
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"testing"
+	"time"
+)
+
+type BetweenCase struct {
+	Expr   string
+	Start  string
+	End    string
+	Expect []string
+}
+
+func TestOccurrencesBetween(t *testing.T) {
+	gron := New()
+
+	for _, test := range betweencases() {
+		t.Run("occurrences between "+test.Expr+" "+test.Start, func(t *testing.T) {
+			start, _ := time.Parse(dateFormat, test.Start)
+			end, _ := time.Parse(dateFormat, test.End)
+
+			times, err := gron.OccurrencesBetween(test.Expr, start, end)
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if times == nil || len(times) != len(test.Expect) {
+				t.Fatalf("expected %v, got %v", test.Expect, times)
+			}
+			for i, next := range times {
+				if actual := next.Format(dateFormat); actual != test.Expect[i] {
+					t.Errorf("expected %v, got %v", test.Expect[i], actual)
+				}
+			}
+		})
+	}
+
+	t.Run("occurrences between dst", func(t *testing.T) {
+		loc, err := time.LoadLocation("America/New_York")
+		abort(err)
+
+		spring, err := gron.OccurrencesBetween("*/30 * * * *", time.Date(2021, 3, 14, 0, 0, 0, 0, loc), time.Date(2021, 3, 14, 4, 0, 0, 0, loc))
+		if err != nil || len(spring) != 7 {
+			t.Errorf("expected 7 due times, got %v, %v", spring, err)
+		}
+
+		fall, err := gron.OccurrencesBetween("0 * * * *", time.Date(2021, 11, 7, 0, 0, 0, 0, loc), time.Date(2021, 11, 7, 3, 0, 0, 0, loc))
+		if err != nil || len(fall) != 5 {
+			t.Errorf("expected 5 due times, got %v, %v", fall, err)
+		}
+		for i := 1; i < len(fall); i++ {
+			if fall[i].Sub(fall[i-1]) != time.Hour {
+				t.Errorf("expected hourly due times, got %v", fall)
+			}
+		}
+	})
+
+	t.Run("occurrences between capped", func(t *testing.T) {
+		start := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+		times, err := gron.OccurrencesBetween("* * * * *", start, start.AddDate(10, 0, 0))
+		if err == nil {
+			t.Errorf("expected error, got nil")
+		}
+		if len(times) != MaxOccurrences {
+			t.Errorf("expected %d due times, got %d", MaxOccurrences, len(times))
+		}
+	})
+
+	t.Run("occurrences between invalid", func(t *testing.T) {
+		if _, err := gron.OccurrencesBetween("* * *", time.Now(), time.Now()); err == nil {
+			t.Errorf("expected error, got nil")
+		}
+	})
+}
+
+func betweencases() []BetweenCase {
+	return []BetweenCase{
+		{"*/15 * * * *", "2021-04-19 12:00:00", "2021-04-19 13:00:00", []string{
+			"2021-04-19 12:00:00", "2021-04-19 12:15:00", "2021-04-19 12:30:00", "2021-04-19 12:45:00", "2021-04-19 13:00:00",
+		}},
+		{"*/15 * * * *", "2021-04-19 12:00:01", "2021-04-19 12:59:59", []string{
+			"2021-04-19 12:15:00", "2021-04-19 12:30:00", "2021-04-19 12:45:00",
+		}},
+		{"0 0 L * *", "2021-01-15 00:00:00", "2021-04-15 00:00:00", []string{
+			"2021-01-31 00:00:00", "2021-02-28 00:00:00", "2021-03-31 00:00:00",
+		}},
+		{"0 0 1 1 *", "2021-01-02 00:00:00", "2021-12-31 23:59:00", []string{}},
+		{"0 0 * * *", "2021-04-19 12:00:00", "2021-04-18 12:00:00", []string{}},
+	}
+}
+
+func TestIsDueBetween(t *testing.T) {
+	gron := New()
+	tests := []struct {
+		expr, start, end string
+		expect           bool
+	}{
+		{"0 * * * *", "2021-04-19 12:30:00", "2021-04-19 13:00:00", true},
+		{"0 * * * *", "2021-04-19 12:30:00", "2021-04-19 12:59:59", false},
+		{"0 0 29 2 *", "2021-03-01 00:00:00", "2024-02-29 00:00:00", true},
+		{"0 0 29 2 *", "2021-03-01 00:00:00", "2024-02-28 23:59:00", false},
+		{"* * * * *", "2021-04-19 12:30:10", "2021-04-19 12:30:50", false},
+		{"* * * * *", "2021-04-19 12:30:10", "2021-04-19 12:31:00", true},
+		{"* * * * *", "2021-04-19 12:30:00", "2021-04-19 12:30:00", true},
+	}
+
+	for _, test := range tests {
+		t.Run("is due between "+test.expr+" "+test.start, func(t *testing.T) {
+			start, _ := time.Parse(dateFormat, test.start)
+			end, _ := time.Parse(dateFormat, test.end)
+
+			actual, err := gron.IsDueBetween(test.expr, start, end)
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if actual != test.expect {
+				t.Errorf("expected %v, got %v", test.expect, actual)
+			}
+		})
+	}
+
+	t.Run("is due between inverted", func(t *testing.T) {
+		now := time.Now()
+		if _, err := gron.IsDueBetween("* * * * *", now, now.Add(-time.Minute)); err == nil {
+			t.Errorf("expected error, got nil")
+		}
+	})
+
+	t.Run("is due between invalid", func(t *testing.T) {
+		now := time.Now()
+		if _, err := gron.IsDueBetween("* * *", now, now); err == nil {
+			t.Errorf("expected error, got nil")
+		}
+	})
+}
+
+func TestCountOccurrences(t *testing.T) {
+	gron := New()
+	day, year := 24*time.Hour, 365*24*time.Hour
+	spans := []struct {
+		expr string
+		span time.Duration
+	}{
+		{"* * * * *", day}, {"*/5 * * * *", day}, {"*/7 * * * *", day}, {"*/45 * * * *", day}, {"0 * * * *", 30 * day},
+		{"0 0 29 2 *", 8 * year}, {"0 0 31 * *", 2 * year}, {"0 0 13 * 5", 2 * year}, {"0 0 L * *", 2 * year},
+		{"0 12 * * 1#2", year}, {"0 0 * * 5L", year},
+	}
+	rnd := rand.New(rand.NewSource(1))
+	base := time.Date(2023, 11, 1, 0, 0, 0, 0, time.UTC)
+
+	for _, test := range spans {
+		expr := test.expr
+		for i := 0; i < 5; i++ {
+			start := base.Add(time.Duration(rnd.Int63n(int64(year))))
+			end := start.Add(time.Duration(rnd.Int63n(int64(test.span))))
+
+			t.Run("count occurrences "+expr+" "+start.Format(dateFormat), func(t *testing.T) {
+				times, err := gron.OccurrencesBetween(expr, start, end)
+				if err != nil {
+					t.Fatalf("expected no error, got %v", err)
+				}
+
+				count, err := gron.CountOccurrences(expr, start, end)
+				if err != nil {
+					t.Fatalf("expected no error, got %v", err)
+				}
+				if count != len(times) {
+					t.Errorf("expected %d, got %d", len(times), count)
+				}
+			})
+		}
+	}
+
+	t.Run("count occurrences dst", func(t *testing.T) {
+		loc, err := time.LoadLocation("America/New_York")
+		abort(err)
+
+		start, end := time.Date(2021, 3, 13, 0, 0, 0, 0, loc), time.Date(2021, 11, 8, 0, 0, 0, 0, loc)
+		count, err := gron.CountOccurrences("*/5 * * * *", start, end)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if expect := int(end.Sub(start)/(5*time.Minute)) + 1; count != expect {
+			t.Errorf("expected %d, got %d", expect, count)
+		}
+	})
+
+	t.Run("count occurrences invalid", func(t *testing.T) {
+		if _, err := gron.CountOccurrences("* * *", time.Now(), time.Now()); err == nil {
+			t.Errorf("expected error, got nil")
+		}
+	})
+}
+
+func TestWalkOccurrences(t *testing.T) {
+	gron := New()
+	start := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("walk occurrences", func(t *testing.T) {
+		end := start.AddDate(2, 0, 0)
+		expect, _ := gron.OccurrencesBetween("0 0 L * *", start, end)
+
+		times := []time.Time{}
+		err := gron.WalkOccurrences(context.Background(), "0 0 L * *", start, end, func(next time.Time) error {
+			times = append(times, next)
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if len(times) != len(expect) || len(times) != 24 {
+			t.Fatalf("expected %v, got %v", expect, times)
+		}
+		for i := range times {
+			if !times[i].Equal(expect[i]) {
+				t.Errorf("expected %v, got %v", expect[i], times[i])
+			}
+		}
+	})
+
+	t.Run("walk occurrences fn error", func(t *testing.T) {
+		stop, count := errors.New("stop"), 0
+		err := gron.WalkOccurrences(context.Background(), "* * * * *", start, start.AddDate(1, 0, 0), func(time.Time) error {
+			if count++; count == 10 {
+				return stop
+			}
+			return nil
+		})
+		if err != stop || count != 10 {
+			t.Errorf("expected stop after 10 calls, got %v after %d", err, count)
+		}
+	})
+
+	t.Run("walk occurrences cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		count := 0
+		err := gron.WalkOccurrences(ctx, "* * * * *", start, start.AddDate(100, 0, 0), func(time.Time) error {
+			if count++; count == 1000 {
+				cancel()
+			}
+			return nil
+		})
+		if err != context.Canceled || count != 1000 {
+			t.Errorf("expected cancel after 1000 calls, got %v after %d", err, count)
+		}
+	})
+
+	t.Run("walk occurrences cancelled sparse", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		err := gron.WalkOccurrences(ctx, "0 0 30 2 *", start, start.AddDate(100, 0, 0), func(time.Time) error {
+			t.Errorf("expected no call")
+			return nil
+		})
+		if err != context.Canceled {
+			t.Errorf("expected cancelled, got %v", err)
+		}
+	})
+
+	t.Run("walk occurrences invalid", func(t *testing.T) {
+		if err := gron.WalkOccurrences(context.Background(), "* * *", start, start, nil); err == nil {
+			t.Errorf("expected error, got nil")
+		}
+	})
+}
+
+func TestMissedRuns(t *testing.T) {
+	gron := New()
+	tests := []BetweenCase{
+		{"0 * * * *", "2021-04-19 10:00:00", "2021-04-19 13:00:00", []string{
+			"2021-04-19 11:00:00", "2021-04-19 12:00:00", "2021-04-19 13:00:00",
+		}},
+		{"0 * * * *", "2021-04-19 10:00:30", "2021-04-19 12:59:59", []string{
+			"2021-04-19 11:00:00", "2021-04-19 12:00:00",
+		}},
+		{"0 0 * * *", "2021-04-19 10:00:00", "2021-04-19 13:00:00", []string{}},
+	}
+
+	for _, test := range tests {
+		t.Run("missed runs "+test.Expr+" "+test.Start, func(t *testing.T) {
+			lastRun, _ := time.Parse(dateFormat, test.Start)
+			now, _ := time.Parse(dateFormat, test.End)
+
+			times, err := gron.MissedRuns(test.Expr, lastRun, now)
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if len(times) != len(test.Expect) {
+				t.Fatalf("expected %v, got %v", test.Expect, times)
+			}
+			for i, next := range times {
+				if actual := next.Format(dateFormat); actual != test.Expect[i] {
+					t.Errorf("expected %v, got %v", test.Expect[i], actual)
+				}
+			}
+		})
+	}
+
+	t.Run("missed runs dst", func(t *testing.T) {
+		loc, err := time.LoadLocation("America/New_York")
+		abort(err)
+
+		lastRun, now := time.Date(2021, 3, 14, 1, 30, 0, 0, loc), time.Date(2021, 3, 14, 3, 30, 0, 0, loc)
+		times, err := gron.MissedRuns("*/30 * * * *", lastRun, now)
+		if err != nil || len(times) != 2 || !times[0].Equal(lastRun.Add(30*time.Minute)) {
+			t.Errorf("expected 03:00 and 03:30, got %v, %v", times, err)
+		}
+	})
+
+	t.Run("missed runs capped", func(t *testing.T) {
+		now := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+		times, err := gron.MissedRuns("* * * * *", now.AddDate(-5, 0, 0), now)
+		if err == nil {
+			t.Errorf("expected error, got nil")
+		}
+		if len(times) != MaxOccurrences {
+			t.Errorf("expected %d due times, got %d", MaxOccurrences, len(times))
+		}
+	})
+
+	t.Run("missed runs invalid", func(t *testing.T) {
+		if _, err := gron.MissedRuns("* * *", time.Now(), time.Now()); err == nil {
+			t.Errorf("expected error, got nil")
+		}
+	})
+}
+
+func TestOccurrencesSeconds(t *testing.T) {
+	gron := New(WithSecondsField())
+	start := time.Date(2021, 4, 19, 10, 0, 0, 0, time.UTC)
+	end := start.Add(2 * time.Minute)
+
+	t.Run("occurrences between seconds", func(t *testing.T) {
+		times, err := gron.OccurrencesBetween("*/10 * * * * *", start, end)
+		if err != nil || len(times) != 13 {
+			t.Fatalf("expected 13 due times, got %v, %v", times, err)
+		}
+		if actual := times[1].Format(dateFormat); actual != "2021-04-19 10:00:10" {
+			t.Errorf("expected 2021-04-19 10:00:10, got %v", actual)
+		}
+
+		times, err = gron.OccurrencesBetween("*/10 * * * * *", start.Add(time.Second), end)
+		if err != nil || len(times) != 12 || !times[0].Equal(start.Add(10*time.Second)) {
+			t.Errorf("expected 12 due times from 10:00:10, got %v, %v", times, err)
+		}
+	})
+
+	t.Run("missed runs seconds", func(t *testing.T) {
+		times, err := gron.MissedRuns("*/10 * * * * *", start, end)
+		if err != nil || len(times) != 12 || !times[0].Equal(start.Add(10*time.Second)) {
+			t.Errorf("expected 12 due times from 10:00:10, got %v, %v", times, err)
+		}
+	})
+
+	t.Run("is due between seconds", func(t *testing.T) {
+		for _, test := range []struct {
+			from, to int
+			expect   bool
+		}{{21, 39, true}, {30, 30, true}, {31, 39, false}} {
+			due, err := gron.IsDueBetween("30 * * * * *", start.Add(time.Duration(test.from)*time.Second), start.Add(time.Duration(test.to)*time.Second))
+			if err != nil || due != test.expect {
+				t.Errorf("expected %v from :%d to :%d, got %v, %v", test.expect, test.from, test.to, due, err)
+			}
+		}
+	})
+
+	t.Run("count occurrences seconds", func(t *testing.T) {
+		for expr, expect := range map[string]int{"*/10 * * * * *": 13, "* * * * * *": 121, "0 * * * * *": 3} {
+			count, err := gron.CountOccurrences(expr, start, end)
+			if err != nil || count != expect {
+				t.Errorf("expected %d for %s, got %d, %v", expect, expr, count, err)
+			}
+		}
+	})
+
+	t.Run("walk occurrences seconds", func(t *testing.T) {
+		count := 0
+		err := gron.WalkOccurrences(context.Background(), "*/10 * * * * *", start, end, func(time.Time) error {
+			count++
+			return nil
+		})
+		if err != nil || count != 13 {
+			t.Errorf("expected 13 due times, got %d, %v", count, err)
+		}
+	})
+}
@@ -0,0 +1,220 @@
+package gronx
+
+import (
+	"strings"
+	"time"
+)
+
+// Option is the functional option to configure Gronx.
+type Option func(*Gronx)
+
+// WithExclusive makes GetNext, GetPrev and their variants exclude the minute of the reference
+// time even if it is due, so they give the due time strictly after or before that minute.
+// By default that minute is included.
+func WithExclusive() Option {
+	return func(g *Gronx) {
+		g.exclusive = true
+	}
+}
+
+// WithSearchYears sets how many calendar years away from reference time GetNext, GetPrev
+// and their variants look for due time before giving up with SearchError. By default they
+// look until the year bounds, and never past them.
+func WithSearchYears(years int) Option {
+	return func(g *Gronx) {
+		g.searchYears = years
+	}
+}
+
+// WithYearBounds sets the earliest and latest years allowed in year segment, which are
+// 1970-2099 by default, for historical or far future schedules. GetNext, GetPrev and their
+// variants look for due time within them too. It is ignored if min is after max.
+func WithYearBounds(min, max int) Option {
+	return func(g *Gronx) {
+		if min <= max {
+			g.years = yearRange{min, max}
+		}
+	}
+}
+
+// yearRange gives the year bounds of Gronx, the default ones unless WithYearBounds.
+func (g *Gronx) yearRange() yearRange {
+	if g.years == (yearRange{}) {
+		return defaultYears
+	}
+
+	return g.years
+}
+
+// horizon gives the time until which due time is searched from ref, backwards if prev is true.
+// It is the end of year bounds, or searchYears away from ref if that is sooner.
+func (g *Gronx) horizon(ref time.Time, prev bool) time.Time {
+	limit := searchLimit(ref, g.yearRange(), prev)
+	if g.searchYears < 1 {
+		return limit
+	}
+
+	years := g.searchYears
+	if prev {
+		years = -years
+	}
+	if near := ref.AddDate(years, 0, 0); (prev && near.After(limit)) || (!prev && near.Before(limit)) {
+		return near
+	}
+
+	return limit
+}
+
+// WithHashKey sets the key from which H in cron segments resolves to a stable pseudo-random
+// value, like Jenkins does, so that jobs with different keys are spread out. Eg: H * * * *
+// is due once an hour at the minute derived from key and H/15 is every 15 minutes from it.
+// Cron expressions with H are invalid without a key.
+func WithHashKey(key string) Option {
+	return func(g *Gronx) {
+		g.hashKey = key
+	}
+}
+
+// WithSeed sets the seed from which R and ~ in cron segments pick a random value, like BusyBox
+// crond does for ~. Eg: R 3 * * * is due at a random minute of 3 o'clock and 0~59/10 is every
+// 10 minutes from a random minute in the first 10. The pick is stable for the lifetime of Gronx and the
+// seed defaults to the time it was initialized, so fixing it makes the picks reproducible.
+func WithSeed(seed int64) Option {
+	return func(g *Gronx) {
+		g.seed = seed
+	}
+}
+
+// WithAnchor sets the time from which @every intervals are counted. Default is Unix epoch.
+func WithAnchor(anchor time.Time) Option {
+	return func(g *Gronx) {
+		g.anchor = anchor.Round(0)
+	}
+}
+
+// WithISOWeekday makes numeric week days ISO, ie 1 is monday and 7 is sunday while 0 is invalid.
+// Named week days like MON and SUN and the macros like @weekly mean just the same as without it.
+func WithISOWeekday() Option {
+	return func(g *Gronx) {
+		g.iso = true
+	}
+}
+
+// WithWeekStart sets the week day @weekly is due on, which is sunday by default, eg: time.Monday
+// makes it 0 0 * * 1. The week days in cron expressions mean just the same as without it.
+func WithWeekStart(day time.Weekday) Option {
+	return func(g *Gronx) {
+		if day >= time.Sunday && day <= time.Saturday {
+			g.weekStart = day
+		}
+	}
+}
+
+// WithCache makes Gronx cache the compiled form of up to size cron expressions by expr as given,
+// including the errors of invalid ones, so that checking the same expr again doesn't parse it
+// again. The least recently used one is evicted when full. It is shared by the copies of Gronx
+// and can be cleared with ClearCache. It is ignored if size is not positive.
+func WithCache(size int) Option {
+	return func(g *Gronx) {
+		if size > 0 {
+			g.cache = newParseCache(size)
+		}
+	}
+}
+
+// WithSecondsField makes cron expressions have seconds as the first of 6 segments, like Quartz
+// and Spring, instead of year as the last. The due times are then checked and searched by second.
+// The macros like @hourly are due at 0th second.
+func WithSecondsField() Option {
+	return func(g *Gronx) {
+		g.seconds = true
+	}
+}
+
+// WithQuartz makes cron expressions Quartz like, ie seconds first then 5 segments and optional
+// year as 7th, where week days are numbered 1 (sunday) to 7 (saturday) and L alone in week day
+// means saturday. The ? is strict as with WithStrict. Named week days and the macros like
+// @weekly mean just the same as without it.
+func WithQuartz() Option {
+	return func(g *Gronx) {
+		g.seconds, g.quartz, g.strict = true, true, true
+	}
+}
+
+// WithStrict makes ? in cron expressions strict like Quartz, ie it is only allowed in day of month
+// and week day, and if one of them is restricted the other must be ?. Eg: 0 0 13 * ? is valid
+// but 0 0 13 * * and ? ? * * * are not. Macros are exempt. By default ? is just the same as * anywhere.
+func WithStrict() Option {
+	return func(g *Gronx) {
+		g.strict = true
+	}
+}
+
+// WithYearField makes cron expressions have year as the optional 6th segment. This is the default,
+// and it undoes WithSecondsField or WithQuartz given before it.
+func WithYearField() Option {
+	return func(g *Gronx) {
+		g.seconds, g.quartz = false, false
+	}
+}
+
+// WithDayOr makes day of month and week day due if either of them is due when both are restricted,
+// like Vixie and POSIX cron. Eg: 0 0 13 * FRI is due on every 13th and every friday. A segment is
+// unrestricted if it starts with * (eg: */2) or is ?, in which case both must be due as by default.
+func WithDayOr() Option {
+	return func(g *Gronx) {
+		g.dayOr = true
+	}
+}
+
+// WithDialect narrows the grammar of cron expressions to that of dialect, so that the constructs
+// it doesn't have are invalid with error telling which. DialectPOSIX allows only 5 segments of
+// numbers, ranges and lists (no macros, names, steps or modifiers like L, W, #, H or ?), and
+// makes day of month and week day due if either of them is due as WithDayOr.
+func WithDialect(dialect Dialect) Option {
+	return func(g *Gronx) {
+		if g.dialect = dialect; dialect == DialectPOSIX {
+			g.dayOr = true
+		}
+	}
+}
+
+// WithLocation sets the time zone in which cron expr is checked for due, as if the reference
+// time was in it, and the next/previous due times are given in it. The CRON_TZ= or TZ= prefix
+// of expr wins over it. Without it (or with nil), the location of reference time is used.
+func WithLocation(loc *time.Location) Option {
+	return func(g *Gronx) {
+		g.loc = loc
+	}
+}
+
+// WithBatchWorkers makes BatchDue check the exprs in up to n goroutines. By default (or if n is
+// less than 2) they are checked one after another.
+func WithBatchWorkers(n int) Option {
+	return func(g *Gronx) {
+		g.workers = n
+	}
+}
+
+// WithCanonicalNames makes Canonicalize write the values of month and week day as their names
+// (eg: 0 0 * JAN-MAR MON-FRI), where steps are kept as numbers. By default they are numbers.
+func WithCanonicalNames() Option {
+	return func(g *Gronx) {
+		g.canonicalNames = true
+	}
+}
+
+// dayRestricted checks if both day of month and week day of segs are restricted.
+func dayRestricted(segs []string) bool {
+	if len(segs) <= PosDayOfWeek {
+		return false
+	}
+
+	for _, seg := range []string{segs[PosDayOfMonth], segs[PosDayOfWeek]} {
+		if seg == "?" || strings.HasPrefix(seg, "*") {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,491 @@
+package gronx
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+type ExclusiveCase struct {
+	Expr      string
+	Ref       string
+	Inclusive string
+	Exclusive string
+}
+
+func TestWithExclusive(t *testing.T) {
+	incl, excl := New(), New(WithExclusive())
+
+	for _, test := range nextexclusivecases() {
+		ref, _ := time.Parse(dateFormat, test.Ref)
+		t.Run("next inclusive "+test.Expr+" "+test.Ref, func(t *testing.T) {
+			next, err := incl.GetNext(test.Expr, ref)
+			if err != nil || next.Format(dateFormat) != test.Inclusive {
+				t.Errorf("expected %v, got %v, %v", test.Inclusive, next, err)
+			}
+		})
+		t.Run("next exclusive "+test.Expr+" "+test.Ref, func(t *testing.T) {
+			next, err := excl.GetNext(test.Expr, ref)
+			if err != nil || next.Format(dateFormat) != test.Exclusive {
+				t.Errorf("expected %v, got %v, %v", test.Exclusive, next, err)
+			}
+		})
+	}
+
+	for _, test := range prevexclusivecases() {
+		ref, _ := time.Parse(dateFormat, test.Ref)
+		t.Run("prev inclusive "+test.Expr+" "+test.Ref, func(t *testing.T) {
+			prev, err := incl.GetPrev(test.Expr, ref)
+			if err != nil || prev.Format(dateFormat) != test.Inclusive {
+				t.Errorf("expected %v, got %v, %v", test.Inclusive, prev, err)
+			}
+		})
+		t.Run("prev exclusive "+test.Expr+" "+test.Ref, func(t *testing.T) {
+			prev, err := excl.GetPrev(test.Expr, ref)
+			if err != nil || prev.Format(dateFormat) != test.Exclusive {
+				t.Errorf("expected %v, got %v, %v", test.Exclusive, prev, err)
+			}
+		})
+	}
+
+	t.Run("next n exclusive", func(t *testing.T) {
+		ref, _ := time.Parse(dateFormat, "2021-04-19 10:00:00")
+		times, err := excl.GetNextN("0 * * * *", ref, 2)
+		if err != nil || len(times) != 2 || times[0].Format(dateFormat) != "2021-04-19 11:00:00" {
+			t.Errorf("expected 2021-04-19 11:00:00 first, got %v, %v", times, err)
+		}
+	})
+
+	t.Run("prev n exclusive", func(t *testing.T) {
+		ref, _ := time.Parse(dateFormat, "2021-04-19 10:00:00")
+		times, err := excl.GetPrevN("0 * * * *", ref, 2)
+		if err != nil || len(times) != 2 || times[0].Format(dateFormat) != "2021-04-19 09:00:00" {
+			t.Errorf("expected 2021-04-19 09:00:00 first, got %v, %v", times, err)
+		}
+	})
+}
+
+func nextexclusivecases() []ExclusiveCase {
+	return []ExclusiveCase{
+		{"* * * * *", "2021-04-19 10:05:00", "2021-04-19 10:05:00", "2021-04-19 10:06:00"},
+		{"* * * * *", "2021-04-19 10:05:30", "2021-04-19 10:05:00", "2021-04-19 10:06:00"},
+		{"0 * * * *", "2021-04-19 10:00:00", "2021-04-19 10:00:00", "2021-04-19 11:00:00"},
+		{"0 0 * * *", "2021-04-19 00:00:45", "2021-04-19 00:00:00", "2021-04-20 00:00:00"},
+		{"0 0 1 1 *", "2021-01-01 00:00:00", "2021-01-01 00:00:00", "2022-01-01 00:00:00"},
+		{"0 0 1 1 *", "2021-12-31 23:59:59", "2022-01-01 00:00:00", "2022-01-01 00:00:00"},
+	}
+}
+
+func prevexclusivecases() []ExclusiveCase {
+	return []ExclusiveCase{
+		{"* * * * *", "2021-04-19 10:05:00", "2021-04-19 10:05:00", "2021-04-19 10:04:00"},
+		{"* * * * *", "2021-04-19 10:05:30", "2021-04-19 10:05:00", "2021-04-19 10:04:00"},
+		{"0 * * * *", "2021-04-19 10:00:00", "2021-04-19 10:00:00", "2021-04-19 09:00:00"},
+		{"0 0 * * *", "2021-04-19 00:00:45", "2021-04-19 00:00:00", "2021-04-18 00:00:00"},
+		{"0 0 1 1 *", "2021-01-01 00:00:00", "2021-01-01 00:00:00", "2020-01-01 00:00:00"},
+		{"0 0 1 1 *", "2021-01-01 00:01:00", "2021-01-01 00:00:00", "2021-01-01 00:00:00"},
+	}
+}
+
+func TestWithSearchYears(t *testing.T) {
+	ref, _ := time.Parse(dateFormat, "2021-04-19 10:00:00")
+
+	t.Run("search years next", func(t *testing.T) {
+		short, long := New(WithSearchYears(5)), New(WithSearchYears(10))
+		if _, err := short.GetNext("0 0 1 1 * 2030", ref); err == nil {
+			t.Errorf("expected error, got nil")
+		}
+		if next, err := long.GetNext("0 0 1 1 * 2030", ref); err != nil || next.Year() != 2030 {
+			t.Errorf("expected 2030, got %v, %v", next, err)
+		}
+	})
+
+	t.Run("search years prev", func(t *testing.T) {
+		gron := New(WithSearchYears(2))
+		_, err := gron.GetPrev("0 0 1 1 * 2018", ref)
+
+		var serr *SearchError
+		if !errors.As(err, &serr) {
+			t.Fatalf("expected SearchError, got %v", err)
+		}
+		if serr.Year != 2019 || !serr.Prev || serr.Expr != "0 0 1 1 * 2018" || !serr.Ref.Equal(ref) {
+			t.Errorf("expected year 2019 for prev of given expr and ref, got %+v", serr)
+		}
+		if !errors.Is(err, ErrNoOccurrence) {
+			t.Errorf("expected ErrNoOccurrence, got %v", err)
+		}
+		if expect := `could not find previous due for cron expression "0 0 1 1 * 2018" from 2021-04-19T10:00:00Z until year 2019`; err.Error() != expect {
+			t.Errorf("expected %s, got %s", expect, err.Error())
+		}
+	})
+
+	t.Run("search years n", func(t *testing.T) {
+		gron := New(WithSearchYears(1))
+		_, err := gron.GetNextN("0 0 1 1 * 2022", ref, 2)
+
+		var serr *SearchError
+		if !errors.As(err, &serr) || serr.Year != 2023 {
+			t.Errorf("expected SearchError until 2023, got %v", err)
+		}
+	})
+}
+
+func TestWithYearBounds(t *testing.T) {
+	ref, _ := time.Parse(dateFormat, "2021-04-19 10:00:00")
+
+	t.Run("year bounds default", func(t *testing.T) {
+		gron := New()
+		if err := gron.Validate("0 0 1 1 * 2300"); err == nil || !strings.Contains(err.Error(), "year should be 1970-2099") {
+			t.Errorf("expected year should be 1970-2099, got %v", err)
+		}
+
+		var serr *SearchError
+		if _, err := gron.GetNext("0 0 1 1 *", time.Date(2099, time.June, 1, 0, 0, 0, 0, time.UTC)); !errors.As(err, &serr) || serr.Year != 2099 {
+			t.Errorf("expected SearchError until 2099, got %v", err)
+		}
+		if _, err := gron.GetPrev("0 0 1 1 *", time.Date(1969, time.June, 1, 0, 0, 0, 0, time.UTC)); !errors.As(err, &serr) || serr.Year != 1970 {
+			t.Errorf("expected SearchError until 1970, got %v", err)
+		}
+	})
+
+	t.Run("year bounds custom", func(t *testing.T) {
+		gron := New(WithYearBounds(1900, 2500))
+		if err := gron.Validate("0 0 1 1 * 1950,2300"); err != nil {
+			t.Errorf("expected no error, got %v", err)
+		}
+		if err := gron.Validate("0 0 1 1 * 2501"); err == nil || !strings.Contains(err.Error(), "year should be 1900-2500") {
+			t.Errorf("expected year should be 1900-2500, got %v", err)
+		}
+		if next, err := gron.GetNext("0 0 1 1 * 2300", ref); err != nil || next.Year() != 2300 {
+			t.Errorf("expected 2300, got %v, %v", next, err)
+		}
+		if prev, err := gron.GetPrev("0 0 1 1 * 1950", ref); err != nil || prev.Year() != 1950 {
+			t.Errorf("expected 1950, got %v, %v", prev, err)
+		}
+		if fire, err := gron.WillEverFire("0 0 29 2 * 2300"); err != nil || fire {
+			t.Errorf("expected never fire as 2300 is not leap, got %v, %v", fire, err)
+		}
+	})
+
+	t.Run("year bounds search years", func(t *testing.T) {
+		gron := New(WithYearBounds(1900, 2500), WithSearchYears(100))
+		if _, err := gron.GetNext("0 0 1 1 * 2300", ref); err == nil {
+			t.Errorf("expected error, got nil")
+		}
+	})
+
+	t.Run("year bounds inverted", func(t *testing.T) {
+		gron := New(WithYearBounds(2500, 1900))
+		if err := gron.Validate("0 0 1 1 * 2099"); err != nil {
+			t.Errorf("expected default bounds, got %v", err)
+		}
+	})
+}
+
+func TestWithLocation(t *testing.T) {
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	abort(err)
+	angeles, err := time.LoadLocation("America/Los_Angeles")
+	abort(err)
+
+	t.Run("location east", func(t *testing.T) {
+		// It is already tuesday 2021-04-20 08:30 in Tokyo.
+		ref, gron := time.Date(2021, time.April, 19, 23, 30, 0, 0, time.UTC), New(WithLocation(tokyo))
+		if due, err := gron.IsDue("30 8 20 * 2", ref); err != nil || !due {
+			t.Errorf("expected due, got %v, %v", due, err)
+		}
+
+		next, err := gron.GetNext("0 0 * * *", ref)
+		if expect := time.Date(2021, time.April, 21, 0, 0, 0, 0, tokyo); err != nil || !next.Equal(expect) || next.Location() != tokyo {
+			t.Errorf("expected %v, got %v, %v", expect, next, err)
+		}
+	})
+
+	t.Run("location west", func(t *testing.T) {
+		// It is still monday 2021-04-19 20:00 in Los Angeles.
+		ref, gron := time.Date(2021, time.April, 20, 3, 0, 0, 0, time.UTC), New(WithLocation(angeles))
+		if due, err := gron.IsDue("0 20 19 * 1", ref); err != nil || !due {
+			t.Errorf("expected due, got %v, %v", due, err)
+		}
+
+		prev, err := gron.GetPrev("0 0 * * *", ref)
+		if expect := time.Date(2021, time.April, 19, 0, 0, 0, 0, angeles); err != nil || !prev.Equal(expect) || prev.Location() != angeles {
+			t.Errorf("expected %v, got %v, %v", expect, prev, err)
+		}
+	})
+
+	t.Run("location prefix wins", func(t *testing.T) {
+		ref, gron := time.Date(2021, time.April, 19, 23, 30, 0, 0, time.UTC), New(WithLocation(tokyo))
+		if due, err := gron.IsDue("CRON_TZ=UTC 30 23 19 * 1", ref); err != nil || !due {
+			t.Errorf("expected due, got %v, %v", due, err)
+		}
+	})
+
+	t.Run("location default", func(t *testing.T) {
+		ref, gron := time.Date(2021, time.April, 19, 23, 30, 0, 0, time.UTC), New(WithLocation(nil))
+		if due, err := gron.IsDue("30 23 19 * 1", ref); err != nil || !due {
+			t.Errorf("expected due in location of ref, got %v, %v", due, err)
+		}
+		if next, err := gron.GetNext("0 0 * * *", ref); err != nil || next.Location() != time.UTC {
+			t.Errorf("expected next in UTC, got %v, %v", next, err)
+		}
+	})
+}
+
+func TestWithSecondsField(t *testing.T) {
+	gron := New(WithSecondsField())
+
+	tests := []Case{
+		{"30 * * * * *", "2021-04-19 10:05:30", true},
+		{"30 * * * * *", "2021-04-19 10:05:31", false},
+		{"*/15 5 10 * * *", "2021-04-19 10:05:45", true},
+		{"*/15 5 10 * * *", "2021-04-19 10:05:40", false},
+		{"0-10/5,59 * * * * MON", "2021-04-19 10:05:59", true},
+		{"0 0 0 * * SUN", "2021-04-18 00:00:00", true},
+		{"@hourly", "2021-04-19 10:00:00", true},
+		{"@hourly", "2021-04-19 10:00:01", false},
+	}
+	for _, test := range tests {
+		t.Run("seconds due "+test.Expr+" "+test.Ref, func(t *testing.T) {
+			actual, err := test.run(gron)
+			if err != nil || actual != test.Expect {
+				t.Errorf("expected %v, got %v, %v", test.Expect, actual, err)
+			}
+		})
+	}
+
+	nexts := []ExclusiveCase{
+		{"30 * * * * *", "2021-04-19 10:05:31", "2021-04-19 10:06:30", "2021-04-19 10:05:30"},
+		{"*/20 * * * * *", "2021-04-19 10:05:41", "2021-04-19 10:06:00", "2021-04-19 10:05:40"},
+		{"0 0 0 1 1 *", "2021-04-19 10:05:41", "2022-01-01 00:00:00", "2021-01-01 00:00:00"},
+		{"15 59 23 L * *", "2021-04-19 10:05:41", "2021-04-30 23:59:15", "2021-03-31 23:59:15"},
+		{"10 * 5 * * *", "2021-04-19 05:59:10", "2021-04-19 05:59:10", "2021-04-19 05:59:10"},
+		{"10 * 5 * * *", "2021-04-19 06:00:00", "2021-04-20 05:00:10", "2021-04-19 05:59:10"},
+	}
+	for _, test := range nexts {
+		ref, _ := time.Parse(dateFormat, test.Ref)
+		ref = ref.Add(500 * time.Millisecond)
+		t.Run("seconds next prev "+test.Expr+" "+test.Ref, func(t *testing.T) {
+			if next, err := gron.GetNext(test.Expr, ref); err != nil || next.Format(dateFormat) != test.Inclusive {
+				t.Errorf("expected next %v, got %v, %v", test.Inclusive, next, err)
+			}
+			if prev, err := gron.GetPrev(test.Expr, ref); err != nil || prev.Format(dateFormat) != test.Exclusive {
+				t.Errorf("expected prev %v, got %v, %v", test.Exclusive, prev, err)
+			}
+		})
+	}
+
+	t.Run("seconds n", func(t *testing.T) {
+		ref, _ := time.Parse(dateFormat, "2021-04-19 10:05:00")
+		times, err := gron.GetNextN("*/20 * * * * *", ref, 4)
+		if err != nil || len(times) != 4 || times[3].Format(dateFormat) != "2021-04-19 10:06:00" {
+			t.Errorf("expected 4 times until 10:06:00, got %v, %v", times, err)
+		}
+
+		times, err = gron.GetPrevN("*/20 * * * * *", ref, 2)
+		if err != nil || len(times) != 2 || times[1].Format(dateFormat) != "2021-04-19 10:04:40" {
+			t.Errorf("expected 10:05:00 and 10:04:40, got %v, %v", times, err)
+		}
+	})
+
+	t.Run("seconds exclusive", func(t *testing.T) {
+		gron := New(WithSecondsField(), WithExclusive())
+		ref, _ := time.Parse(dateFormat, "2021-04-19 10:05:30")
+		if next, err := gron.GetNext("* * * * * *", ref); err != nil || next.Format(dateFormat) != "2021-04-19 10:05:31" {
+			t.Errorf("expected 10:05:31, got %v, %v", next, err)
+		}
+		if prev, err := gron.GetPrev("* * * * * *", ref); err != nil || prev.Format(dateFormat) != "2021-04-19 10:05:29" {
+			t.Errorf("expected 10:05:29, got %v, %v", prev, err)
+		}
+	})
+
+	for _, expr := range []string{"* * * * *", "* * * * * * *", "60/0 * * * * *", "*/61 * * * * *", "A * * * * *"} {
+		t.Run("seconds err "+expr, func(t *testing.T) {
+			if gron.IsValid(expr) {
+				t.Errorf("expected false, got true")
+			}
+		})
+	}
+
+	t.Run("seconds hash", func(t *testing.T) {
+		gron := New(WithSecondsField(), WithHashKey("job-a"))
+		segs, err := gron.segments("H * * * * *")
+		if err != nil || mustAtoi(t, segs[PosSecond]) > 59 {
+			t.Errorf("expected hashed second, got %v, %v", segs, err)
+		}
+	})
+}
+
+func TestWithStrict(t *testing.T) {
+	gron, lenient := New(WithStrict()), New()
+
+	for _, expr := range []string{"0 0 13 * ?", "0 0 ? * FRI", "0 0 * * ?", "0 0 ? * *", "* * * * *", "0 0 L * ? 2025", "@weekly"} {
+		t.Run("strict valid "+expr, func(t *testing.T) {
+			if !gron.IsValid(expr) {
+				t.Errorf("expected true, got false")
+			}
+		})
+	}
+
+	tests := map[string]string{
+		"? ? * * *":     "not in minute segment",
+		"0 ? * * *":     "not in hour segment",
+		"0 0 * ? *":     "not in month segment",
+		"0 0 * * ? ?":   "not in year segment",
+		"0 0 ? * ?":     "both day of month and week day",
+		"0 0 13 * *":    "week day should be ?",
+		"0 0 13 * FRI":  "week day should be ?",
+		"0 0 * * FRI":   "day of month should be ?",
+		"0 0 1,? * MON": "should be alone",
+	}
+	for expr, expect := range tests {
+		t.Run("strict err "+expr, func(t *testing.T) {
+			if gron.IsValid(expr) {
+				t.Errorf("expected false, got true")
+			}
+			if _, err := gron.IsDue(expr); err == nil || !strings.Contains(err.Error(), expect) {
+				t.Errorf("expected %s, got %v", expect, err)
+			}
+		})
+	}
+
+	t.Run("lenient", func(t *testing.T) {
+		if !lenient.IsValid("? ? * * *") || !lenient.IsValid("0 0 13 * FRI") {
+			t.Errorf("expected ? anywhere to be valid without strict")
+		}
+	})
+
+	t.Run("strict quartz", func(t *testing.T) {
+		gron := New(WithQuartz())
+		if gron.IsValid("0 0 0 13 * FRI") || !gron.IsValid("0 0 0 13 * ?") {
+			t.Errorf("expected Quartz to be strict")
+		}
+	})
+}
+
+func TestWithDayOr(t *testing.T) {
+	gron, and := New(WithDayOr()), New()
+
+	tests := []struct {
+		expr, ref string
+		or, and   bool
+	}{
+		{"0 0 13 * 5", "2021-08-13 00:00:00", true, true},
+		{"0 0 13 * 5", "2021-04-13 00:00:00", true, false},
+		{"0 0 13 * 5", "2021-04-16 00:00:00", true, false},
+		{"0 0 13 * 5", "2021-04-14 00:00:00", false, false},
+		{"0 0 1,15 * MON", "2021-04-15 00:00:00", true, false},
+		{"0 0 1,15 * MON", "2021-04-19 00:00:00", true, false},
+		{"0 0 1,15 * MON", "2021-04-20 00:00:00", false, false},
+		{"0 0 L * SUN", "2021-04-30 00:00:00", true, false},
+		{"0 0 * * 5", "2021-04-13 00:00:00", false, false},
+		{"0 0 * * 5", "2021-04-16 00:00:00", true, true},
+		{"0 0 13 ? 5", "2021-04-13 00:00:00", true, false},
+		{"0 0 13 * ?", "2021-04-13 00:00:00", true, true},
+		{"0 0 */2 * 5", "2021-04-16 00:00:00", false, false},
+		{"0 0 */2 * 5", "2021-04-23 00:00:00", true, true},
+	}
+	for _, test := range tests {
+		ref, _ := time.Parse(dateFormat, test.ref)
+		t.Run("day or "+test.expr+" "+test.ref, func(t *testing.T) {
+			if due, err := gron.IsDue(test.expr, ref); err != nil || due != test.or {
+				t.Errorf("expected %v, got %v, %v", test.or, due, err)
+			}
+			if due, err := and.IsDue(test.expr, ref); err != nil || due != test.and {
+				t.Errorf("expected %v without day or, got %v, %v", test.and, due, err)
+			}
+		})
+	}
+
+	nexts := []ExclusiveCase{
+		{"0 0 13 * 5", "2021-04-14 12:00:00", "2021-04-16 00:00:00", "2021-04-13 00:00:00"},
+		{"0 0 1,15 * MON", "2021-04-16 12:00:00", "2021-04-19 00:00:00", "2021-04-15 00:00:00"},
+		{"0 0 1,15 * MON", "2021-04-14 12:00:00", "2021-04-15 00:00:00", "2021-04-12 00:00:00"},
+		{"0 0 31 2 SAT", "2021-04-14 12:00:00", "2022-02-05 00:00:00", "2021-02-27 00:00:00"},
+	}
+	for _, test := range nexts {
+		ref, _ := time.Parse(dateFormat, test.Ref)
+		t.Run("day or next prev "+test.Expr+" "+test.Ref, func(t *testing.T) {
+			if next, err := gron.GetNext(test.Expr, ref); err != nil || next.Format(dateFormat) != test.Inclusive {
+				t.Errorf("expected next %v, got %v, %v", test.Inclusive, next, err)
+			}
+			if prev, err := gron.GetPrev(test.Expr, ref); err != nil || prev.Format(dateFormat) != test.Exclusive {
+				t.Errorf("expected prev %v, got %v, %v", test.Exclusive, prev, err)
+			}
+		})
+	}
+
+	t.Run("day or n", func(t *testing.T) {
+		ref, _ := time.Parse(dateFormat, "2021-08-01 00:00:00")
+		times, err := gron.GetNextN("0 0 13 * 5", ref, 4)
+		expect := []string{"2021-08-06 00:00:00", "2021-08-13 00:00:00", "2021-08-20 00:00:00", "2021-08-27 00:00:00"}
+		if err != nil || len(times) != len(expect) {
+			t.Fatalf("expected %d times, got %v, %v", len(expect), times, err)
+		}
+		for i, next := range times {
+			if actual := next.Format(dateFormat); actual != expect[i] {
+				t.Errorf("expected %v, got %v", expect[i], actual)
+			}
+		}
+	})
+}
+
+func TestWithWeekStart(t *testing.T) {
+	monday := time.Date(2024, time.January, 8, 0, 0, 0, 0, time.UTC)
+	sunday := monday.AddDate(0, 0, -1)
+
+	t.Run("week start weekly", func(t *testing.T) {
+		gron := New(WithWeekStart(time.Monday))
+		if due, err := gron.IsDue("@weekly", monday); err != nil || !due {
+			t.Errorf("expected due on monday, got %v, %v", due, err)
+		}
+		if due, _ := gron.IsDue("@weekly", sunday); due {
+			t.Errorf("expected not due on sunday, got due")
+		}
+		if prev, err := gron.GetPrev("@WEEKLY", monday.AddDate(0, 0, 2)); err != nil || !prev.Equal(monday) {
+			t.Errorf("expected %v, got %v, %v", monday, prev, err)
+		}
+	})
+
+	t.Run("week start default", func(t *testing.T) {
+		for _, gron := range []Gronx{New(), New(WithWeekStart(time.Sunday)), New(WithWeekStart(time.Weekday(9)))} {
+			if due, err := gron.IsDue("@weekly", sunday); err != nil || !due {
+				t.Errorf("expected due on sunday, got %v, %v", due, err)
+			}
+			if prev, err := gron.GetPrev("@weekly", monday.AddDate(0, 0, 2)); err != nil || !prev.Equal(sunday) {
+				t.Errorf("expected %v, got %v, %v", sunday, prev, err)
+			}
+		}
+	})
+
+	t.Run("week start explicit", func(t *testing.T) {
+		gron := New(WithWeekStart(time.Monday))
+		if due, err := gron.IsDue("0 0 * * 0", sunday); err != nil || !due {
+			t.Errorf("expected due on sunday, got %v, %v", due, err)
+		}
+		seconds, iso := New(WithWeekStart(time.Monday), WithSecondsField()), New(WithWeekStart(time.Monday), WithISOWeekday())
+		if due, err := seconds.IsDue("@weekly", monday); err != nil || !due {
+			t.Errorf("expected due on monday with seconds, got %v, %v", due, err)
+		}
+		if due, err := iso.IsDue("@weekly", monday); err != nil || !due {
+			t.Errorf("expected due on monday with iso, got %v, %v", due, err)
+		}
+	})
+
+	t.Run("week start macros", func(t *testing.T) {
+		gron := New(WithWeekStart(time.Monday))
+		if weekly := gron.Macros()["@weekly"]; weekly != "0 0 * * 1" {
+			t.Errorf("expected 0 0 * * 1, got %s", weekly)
+		}
+		if weekly := Macros()["@weekly"]; weekly != "0 0 * * 0" {
+			t.Errorf("expected global 0 0 * * 0, got %s", weekly)
+		}
+		if expr, err := gron.Canonicalize(" @weekly "); err != nil || expr != "0 0 * * 1" {
+			t.Errorf("expected 0 0 * * 1, got %s, %v", expr, err)
+		}
+		if expr, err := Canonicalize("@weekly"); err != nil || expr != "0 0 * * 0" {
+			t.Errorf("expected 0 0 * * 0, got %s, %v", expr, err)
+		}
+	})
+}
@@ -0,0 +1,311 @@
+package gronx
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// overlapYears is the number of years from the reference time within which FirstOverlap looks
+// for the time that two cron exprs are both due at, when it can't tell the years they are.
+const overlapYears = 4
+
+// Overlaps checks if cron exprs a and b are ever due at the same time from now like
+// FirstOverlap. It returns bool or error if either of them is invalid, or ErrOverlapUndecided
+// if it can't tell.
+func Overlaps(a, b string) (bool, error) {
+	for _, expr := range []string{a, b} {
+		if err := Validate(expr); err != nil {
+			return false, err
+		}
+	}
+
+	gron := New()
+
+	return gron.Overlaps(a, b)
+}
+
+// FirstOverlap gets the first time on or after ref that cron exprs a and b are both due at like
+// IsDue, ie in the same minute. The exprs in different zones (CRON_TZ=) are compared by the
+// instants they are due at, and the time is given in the location of ref. It returns error that
+// wraps ErrNoOccurrence if there is none (within 4 years if it can't tell the years there are),
+// or any other error if either of them is invalid.
+func FirstOverlap(a, b string, ref time.Time) (time.Time, error) {
+	for _, expr := range []string{a, b} {
+		if err := Validate(expr); err != nil {
+			return time.Time{}, err
+		}
+	}
+
+	gron := New()
+
+	return gron.FirstOverlap(a, b, ref)
+}
+
+// Overlaps checks if cron exprs a and b are ever due at the same time from now as per the
+// options of Gronx like FirstOverlap. It returns bool or error if either of them is invalid,
+// or ErrOverlapUndecided if it can't tell, eg: for exprs in different zones not due within
+// 4 years.
+func (g *Gronx) Overlaps(a, b string) (bool, error) {
+	_, sure, err := g.firstOverlap(a, b, time.Now())
+	if errors.Is(err, ErrNoOccurrence) && !sure {
+		return false, fmt.Errorf("%w: %q and %q", ErrOverlapUndecided, a, b)
+	}
+	if errors.Is(err, ErrNoOccurrence) {
+		return false, nil
+	}
+
+	return err == nil, err
+}
+
+// FirstOverlap gets the first time on or after ref that cron exprs a and b are both due at as per
+// the options of Gronx (except WithExclusive), like FirstOverlap. The day of month and week day
+// are due either as per WithDayOr. It returns error that wraps ErrNoOccurrence if there is none,
+// or any other error if either of them is invalid.
+func (g *Gronx) FirstOverlap(a, b string, ref time.Time) (time.Time, error) {
+	at, _, err := g.firstOverlap(a, b, ref)
+
+	return at, err
+}
+
+// firstOverlap gets the first time on or after ref that cron exprs a and b are both due at like
+// FirstOverlap, along with whether it is sure there is none if not found. The exprs in the same
+// zone are decided by the values of their segments and the days they are due on, and then
+// searched until the years they are both due on days in. The others are searched until the
+// latest year they are due in, or within 4 years of ref if there is none.
+func (g *Gronx) firstOverlap(a, b string, ref time.Time) (time.Time, bool, error) {
+	gron := *g
+	gron.exclusive = false
+
+	ea, err := gron.Parse(a)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+	eb, err := gron.Parse(b)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+
+	end, sure := ref.AddDate(overlapYears, 0, 0), false
+	if last, ok := lastYear(ea, eb); ok {
+		end, sure = searchLimit(ref, yearRange{last, last}, false), true
+	}
+	if overlap, decided, last := gron.overlapping(ea, eb, ref); decided && !overlap {
+		return time.Time{}, true, noOverlap(a, b, ref)
+	} else if decided {
+		end, sure = searchLimit(ref, yearRange{last, last}, false), true
+	}
+
+	// Each one is due next on or after the other until they are due at the same time, in the
+	// location of ref for the one without zone.
+	loc := ref.Location()
+	at, err := ea.next(ref, gron.check(ea))
+	for err == nil && !at.After(end) {
+		var other time.Time
+		if other, err = eb.next(at.In(loc), gron.check(eb)); err != nil || other.After(end) {
+			break
+		}
+		if other.Equal(at) {
+			return other.In(loc), true, nil
+		}
+		ea, eb, at = eb, ea, other
+	}
+
+	if err != nil && !errors.Is(err, ErrNoOccurrence) {
+		return time.Time{}, false, err
+	}
+
+	return time.Time{}, sure, noOverlap(a, b, ref)
+}
+
+// noOverlap is the error when cron exprs a and b are not both due at any time from ref.
+// It wraps ErrNoOccurrence.
+func noOverlap(a, b string, ref time.Time) error {
+	return fmt.Errorf("%w for both %q and %q from %s", ErrNoOccurrence, a, b, ref.Format(time.RFC3339))
+}
+
+// lastYear gives the latest year both expressions can be due in, if either has years.
+func lastYear(a, b *Expression) (int, bool) {
+	last, ok := anyYears.max, false
+	for _, e := range []*Expression{a, b} {
+		if e.every > 0 || len(e.segs) <= PosYear {
+			continue
+		}
+		if _, hi, bounded, _ := yearSpan(e.segs[PosYear], anyYears); bounded && hi < last {
+			last, ok = hi, true
+		}
+	}
+
+	return last, ok
+}
+
+// overlapping decides if the expressions in the same zone are ever due at the same time on or
+// after ref, as the minutes, hours (and seconds) they are due at intersect and there is a day
+// both of them are due on. It returns whether they are, whether it could decide, and the year
+// within which they are due together after ref if they are. It can't for @every, the ones in
+// different zones, or where DST could move the wall clock times they are due at to the same.
+func (g *Gronx) overlapping(a, b *Expression, ref time.Time) (bool, bool, int) {
+	if a.every > 0 || b.every > 0 || (a.loc == nil) != (b.loc == nil) || (a.loc != nil && a.loc.String() != b.loc.String()) {
+		return false, false, 0
+	}
+
+	for _, pos := range []int{PosMinute, PosHour, PosSecond} {
+		if pos >= len(a.segs) || pos >= len(b.segs) {
+			continue
+		}
+		valuesA, _ := staticValues(a.segs[pos], pos)
+		valuesB, _ := staticValues(b.segs[pos], pos)
+		if !intersect(valuesA, valuesB) {
+			loc := ref.Location()
+			if a.loc != nil {
+				loc = a.loc
+			}
+			return false, !gapOverlap(a.segs, b.segs, loc, ref.Year()), 0
+		}
+	}
+
+	segsA, segsB := yearSegments(a.segs), yearSegments(b.segs)
+	first, ok := commonYear(segsA, segsB, g.dayOr, ref.Year())
+	if !ok {
+		return false, true, 0
+	}
+	// The days in the first year may all be before ref, so it is searched until the next one.
+	if next, ok := commonYear(segsA, segsB, g.dayOr, first+1); ok {
+		first = next
+	}
+
+	return true, true, first
+}
+
+// yearSegments gives the segments of date of segs, with year segment.
+func yearSegments(segs []string) []string {
+	date := []string{"*", "*", "*", "*", "*", "*"}
+	copy(date, segs[:PosYear])
+	if len(segs) > PosYear {
+		date[PosYear] = segs[PosYear]
+	}
+
+	return date
+}
+
+// commonYear finds the first year on or after from that segsA and segsB are both due on a day
+// in. The days are due alike in the years which are leap or not alike and start on the same
+// week day, so only the first one of those is checked day by day.
+func commonYear(segsA, segsB []string, dayOr bool, from int) (int, bool) {
+	type kind struct {
+		leap    bool
+		weekday time.Weekday
+	}
+
+	seen := map[kind]bool{}
+	for year := from; year <= anyYears.max && len(seen) < 14; year++ {
+		start := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+		if !yearDueIn(segsA, start) || !yearDueIn(segsB, start) {
+			continue
+		}
+		k := kind{time.Date(year, time.December, 31, 0, 0, 0, 0, time.UTC).YearDay() == 366, start.Weekday()}
+		if seen[k] {
+			continue
+		}
+		seen[k] = true
+		for day := start; day.Year() == year; day = day.AddDate(0, 0, 1) {
+			if dueOn(segsA, day, dayOr) && dueOn(segsB, day, dayOr) {
+				return year, true
+			}
+		}
+	}
+
+	return 0, false
+}
+
+// yearDueIn checks if the year segment of segs is due for the year of day.
+func yearDueIn(segs []string, day time.Time) bool {
+	if segs[PosYear] == "*" {
+		return true
+	}
+	due, err := checkDue(segs[PosYear], PosYear, day)
+
+	return due && err == nil
+}
+
+// dueOn checks if day is due for the segments of date of segs like dayDue, where either day of
+// month or week day is enough if dayOr and both of them are restricted.
+func dueOn(segs []string, day time.Time, dayOr bool) bool {
+	if !dayOr || !dayRestricted(segs) {
+		return dayDue(segs, day)
+	}
+
+	either := append([]string{}, segs...)
+	either[PosDayOfWeek] = "*"
+	if dayDue(either, day) {
+		return true
+	}
+	either[PosDayOfMonth], either[PosDayOfWeek] = "*", segs[PosDayOfWeek]
+
+	return dayDue(either, day)
+}
+
+// gapOverlap checks if DST gap in loc within the year could make segsA and segsB due at the
+// same time, as the wall clock times skipped by it are due at the end of it for the segments
+// due at fixed wall clock times.
+func gapOverlap(segsA, segsB []string, loc *time.Location, year int) bool {
+	for _, gap := range gapMinutes(loc, year) {
+		skippedA, skippedB := wallFixed(segsA) && dueWithin(segsA, gap[0], gap[1]), wallFixed(segsB) && dueWithin(segsB, gap[0], gap[1])
+		endA, endB := dueWithin(segsA, gap[1], gap[1]+1), dueWithin(segsB, gap[1], gap[1]+1)
+		if skippedA && (skippedB || endB) || skippedB && endA {
+			return true
+		}
+	}
+
+	return false
+}
+
+// gapMinutes gives the minutes of day skipped by DST gaps in loc within the year, as the first
+// one and the one after the last, which is the end of gap.
+func gapMinutes(loc *time.Location, year int) [][2]int {
+	gaps := [][2]int{}
+	for day := dayStart(year, time.January, 1, loc); day.Year() == year; {
+		next := dayStart(day.Year(), day.Month(), day.Day()+1, loc)
+		// The gap at midnight is the start of next day, so it is looked for a minute after.
+		if gap, ok := gapBetween(day, next.Add(time.Minute), time.Minute); ok {
+			_, before := gap.Add(-time.Minute).Zone()
+			_, after := gap.Zone()
+			end := gap.Hour()*60 + gap.Minute()
+			gaps = append(gaps, [2]int{end - (after-before)/60, end})
+		}
+		day = next
+	}
+
+	return gaps
+}
+
+// dueWithin checks if segs are due at any minute of day from the first to before the last.
+func dueWithin(segs []string, first, last int) bool {
+	hours, _ := staticValues(segs[PosHour], PosHour)
+	minutes, _ := staticValues(segs[PosMinute], PosMinute)
+	for _, hour := range hours {
+		for _, minute := range minutes {
+			if at := hour*60 + minute; at >= first && at < last {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// intersect checks if sorted a and b have any int in common.
+func intersect(a, b []int) bool {
+	for i, j := 0, 0; i < len(a) && j < len(b); {
+		switch {
+		case a[i] == b[j]:
+			return true
+		case a[i] < b[j]:
+			i++
+		default:
+			j++
+		}
+	}
+
+	return false
+}
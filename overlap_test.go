@@ -0,0 +1,128 @@
+package gronx
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestFirstOverlap(t *testing.T) {
+	ref := time.Date(2026, time.October, 14, 10, 0, 0, 0, time.UTC)
+	tests := []struct {
+		a, b   string
+		expect string
+	}{
+		{"0 9 * * 1-5", "0 9 * * 5", "2026-10-16 09:00:00"},
+		{"*/15 * * * *", "*/20 * * * *", "2026-10-14 10:00:00"},
+		{"0 0 L * *", "0 0 * * 5", "2027-04-30 00:00:00"},
+		{"0 0 L 2 *", "0 0 29 2 *", "2028-02-29 00:00:00"},
+		{"0 0 * * 5#2", "0 0 8-14 * fri", "2026-11-13 00:00:00"},
+		{"CRON_TZ=Asia/Kolkata 30 5 * * *", "CRON_TZ=UTC 0 0 * * *", "2026-10-15 00:00:00"},
+		{"CRON_TZ=America/New_York 0 20 * * *", "0 0 * * *", "2026-10-15 00:00:00"},
+		{"0 0 1 1 * 2035", "0 0 1 1 * 2035", "2035-01-01 00:00:00"},
+		{"0 0 29 2 * 2040", "0 0 * * * 2040", "2040-02-29 00:00:00"},
+		{"0 0 29 2 1", "0 0 29 2 *", "2044-02-29 00:00:00"},
+		{"0 0 * * 5#5", "0 0 L 2 *", "2036-02-29 00:00:00"},
+		{"CRON_TZ=America/New_York 30 2 * * *", "CRON_TZ=America/New_York 0 3 * * *", "2027-03-14 07:00:00"},
+	}
+
+	for _, test := range tests {
+		t.Run("overlap "+test.a+" and "+test.b, func(t *testing.T) {
+			for _, pair := range [][2]string{{test.a, test.b}, {test.b, test.a}} {
+				actual, err := FirstOverlap(pair[0], pair[1], ref)
+				if err != nil {
+					t.Fatalf("expected no error, got %v", err)
+				}
+				if at := actual.UTC().Format(dateFormat); at != test.expect {
+					t.Errorf("expected %s, got %s", test.expect, at)
+				}
+			}
+		})
+	}
+
+	t.Run("no overlap", func(t *testing.T) {
+		for _, pair := range [][2]string{
+			{"0 0 * 1 *", "0 0 * 2 *"},
+			{"0 0 1 1 * 2030", "0 0 1 1 * 2031"},
+			{"0 0 13 * 5", "0 0 14 * *"},
+			{"0 0 30 2 *", "* * * * *"},
+			{"0 * * * *", "30 * * * *"},
+			{"CRON_TZ=Asia/Kolkata 0 * * * *", "CRON_TZ=UTC 0 * * * *"},
+			{"0 0 29 2 1", "0 0 * * 2"},
+			{"CRON_TZ=America/New_York 0 2 * * *", "CRON_TZ=America/New_York 0 4 * * *"},
+		} {
+			if _, err := FirstOverlap(pair[0], pair[1], ref); !errors.Is(err, ErrNoOccurrence) {
+				t.Errorf("expected %v for %s and %s, got %v", ErrNoOccurrence, pair[0], pair[1], err)
+			}
+		}
+	})
+
+	t.Run("overlap invalid", func(t *testing.T) {
+		for _, pair := range [][2]string{{"61 * * * *", "* * * * *"}, {"* * * * *", "H * * * *"}, {"@reboot", "* * * * *"}} {
+			if _, err := FirstOverlap(pair[0], pair[1], ref); err == nil || errors.Is(err, ErrNoOccurrence) {
+				t.Errorf("expected invalid expr error for %s and %s, got %v", pair[0], pair[1], err)
+			}
+		}
+	})
+}
+
+func TestGronxFirstOverlap(t *testing.T) {
+	ref := time.Date(2026, time.October, 14, 10, 0, 0, 0, time.UTC)
+
+	gron := New(WithDayOr())
+	actual, err := gron.FirstOverlap("0 0 13 * 5", "0 0 14 * *", ref)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if at := actual.Format(dateFormat); at != "2027-05-14 00:00:00" {
+		t.Errorf("expected 2027-05-14 00:00:00, got %s", at)
+	}
+	if overlaps, err := gron.Overlaps("0 0 13 * 5", "0 0 14 * *"); err != nil || !overlaps {
+		t.Errorf("expected true, got %v (%v)", overlaps, err)
+	}
+
+	gron = New(WithExclusive())
+	if actual, err := gron.FirstOverlap("0 10 * * *", "@hourly", ref); err != nil || !actual.Equal(ref) {
+		t.Errorf("expected %v, got %v (%v)", ref, actual, err)
+	}
+
+	gron = New()
+	if actual, err := gron.FirstOverlap("@every 15m", "*/20 * * * *", ref); err != nil || !actual.Equal(ref) {
+		t.Errorf("expected %v, got %v (%v)", ref, actual, err)
+	}
+}
+
+func TestOverlaps(t *testing.T) {
+	tests := map[[2]string]bool{
+		{"0 9 * * 1-5", "0 9 * * 5"}:          true,
+		{"0 0 * 1 *", "0 0 * 2 *"}:            false,
+		{"0 0 L 2 *", "0 0 29 2 *"}:           true,
+		{"*/2 * * * *", "1-59/2 * * * *"}:     false,
+		{"0 0 1 1 * 2035", "0 0 1 1 * 2035"}:  true,
+		{"0 0 29 2 * 2040", "0 0 * * * 2040"}: true,
+		{"0 0 29 2 1", "0 0 29 2 *"}:          true,
+		{"0 0 29 2 1", "0 0 * * 2"}:           false,
+		{"0 0 13 * 5", "0 0 14 * *"}:          false,
+		{"CRON_TZ=America/Havana 0 0 10 3 *", "CRON_TZ=America/Havana 0 1 10 3 *"}:   true,
+		{"CRON_TZ=America/New_York 0 2 * * *", "CRON_TZ=America/New_York 0 4 * * *"}: false,
+	}
+
+	for pair, expect := range tests {
+		t.Run("overlaps "+pair[0]+" and "+pair[1], func(t *testing.T) {
+			actual, err := Overlaps(pair[0], pair[1])
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if actual != expect {
+				t.Errorf("expected %v, got %v", expect, actual)
+			}
+		})
+	}
+
+	t.Run("overlaps undecided", func(t *testing.T) {
+		_, err := Overlaps("CRON_TZ=Asia/Kolkata 0 * * * *", "CRON_TZ=UTC 0 * * * *")
+		if !errors.Is(err, ErrOverlapUndecided) {
+			t.Errorf("expected %v, got %v", ErrOverlapUndecided, err)
+		}
+	})
+}
@@ -40,7 +40,7 @@ func MustParseTaskfile(opts Option) []Task {
 }
 
 var cronRe = regexp.MustCompile(`^((?:[^\s]+\s+){5}(?:\d{4})?)(?:\s+)?(.*)`)
-var aliasRe = regexp.MustCompile(`^(@(?:annually|yearly|monthly|weekly|daily|hourly|5minutes|10minutes|15minutes|30minutes|always))(?:\s+)?(.*)`)
+var aliasRe = regexp.MustCompile(`^(@(?:annually|yearly|monthly|weekly|daily|hourly|5minutes|10minutes|15minutes|30minutes|always|minutely|midnight|weekday|weekend|quarterly))(?:\s+)?(.*)`)
 
 func linesToTasks(lines []string) []Task {
 	var tasks []Task
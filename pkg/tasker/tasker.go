@@ -202,9 +202,8 @@ func (t *Tasker) Run() {
 		}
 
 		tasks := make(map[string]TaskFunc)
-		t.gron.C.SetRef(ref)
 		for expr, refs := range t.exprs {
-			if due, _ := t.gron.SegmentsDue(strings.Split(expr, " ")); !due {
+			if due, _ := t.gron.SegmentsDue(strings.Split(expr, " "), ref); !due {
 				continue
 			}
 
@@ -237,7 +236,7 @@ func (t *Tasker) doSetup() {
 		t.Log.Printf("[tasker] final tick on or before %s", t.until.Format(dateFormat))
 	}
 
-	sig := make(chan os.Signal)
+	sig := make(chan os.Signal, 1)
 	signal.Notify(sig, os.Interrupt, syscall.SIGTERM)
 
 	go func() {
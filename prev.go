@@ -0,0 +1,196 @@
+package gronx
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// GetPrev gets the previous due time for given cron expr on or before reference time (or now).
+// The seconds and nanoseconds are stripped off, except seconds of sub-minute @every interval
+// and of seconds field if enabled, so any point within a due minute is that minute. The time
+// is in the location of reference time, unless expr has CRON_TZ= or TZ= prefix or WithLocation
+// is used, and its wall clock fields are due there.
+// It returns time or error if any.
+func (g *Gronx) GetPrev(expr string, ref ...time.Time) (*time.Time, error) {
+	e, err := g.Parse(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	if len(ref) > 0 {
+		start = ref[0]
+	}
+	prev, err := e.prev(start, g.check(e))
+	if err != nil {
+		return nil, err
+	}
+
+	return &prev, nil
+}
+
+// GetPrevN gets the previous n due times for given cron expr on or before reference time,
+// in descending order with each strictly before the previous one. If fewer than n due
+// times exist within the search horizon, it returns those found along with error.
+func (g *Gronx) GetPrevN(expr string, ref time.Time, n int) ([]time.Time, error) {
+	e, err := g.Parse(expr)
+	if err != nil {
+		return nil, err
+	}
+	if n < 1 {
+		return []time.Time{}, nil
+	}
+
+	times, ref, check := make([]time.Time, 0, n), e.prevRef(inZone(ref, e.loc)), g.check(e)
+	for len(times) < n {
+		limit := g.horizon(ref, true)
+		prev, ok, err := e.prevUntil(ref, limit, check)
+		if err != nil {
+			return times, err
+		}
+		if !ok {
+			serr := &SearchError{Expr: e.expr, Ref: ref, Year: limit.Year(), Prev: true}
+			return times, fmt.Errorf("found only %d of %d previous due times: %w", len(times), n, serr)
+		}
+
+		times = append(times, prev)
+		ref = prev.Add(-e.span())
+	}
+
+	return times, nil
+}
+
+// jumpFunc moves ref to the last minute (or second, as per step) of the latest due value of the
+// segment at given position before its value in ref, along with whether it could.
+type jumpFunc func(ref time.Time, pos int, step time.Duration) (time.Time, bool)
+
+// prevTime finds the previous due time for segments on or after limit by bumping the most
+// significant segment that is not due to the end of its previous period, or jumping it to its
+// latest due value by jump if not nil, instead of minute by minute. It returns the time,
+// whether it was found and error if any.
+func prevTime(segs []string, ref, limit time.Time, due dueFunc, jump jumpFunc) (time.Time, bool, error) {
+	step := tick(segs)
+	prev := truncTick(ref, step)
+	if len(segs) > 5 {
+		// Jump to the latest year, or fail fast if the earliest year is yet to come.
+		lo, hi, ok, _ := yearSpan(segs[5], anyYears)
+		if ok && (lo > prev.Year() || hi < limit.Year()) {
+			return limit, false, nil
+		}
+		if ok && prev.Year() > hi {
+			prev = dayStart(hi+1, time.January, 1, prev.Location()).Add(-step)
+		}
+	}
+	// Fail fast if no day can ever be due, eg: 31st of February.
+	if fire, err := willFire(segs, due, yearRange{limit.Year(), prev.Year()}); !fire || err != nil {
+		return limit, false, err
+	}
+
+	for !prev.Before(limit) {
+		pos, err := undueSegment(segs, prev, due)
+		if err != nil {
+			return prev, false, err
+		}
+		if pos < 0 && !repeatedWall(segs, prev) {
+			return prev, true, nil
+		}
+		if pos < 0 {
+			// The wall clock times repeated by DST fall-back are due only the first time.
+			prev = prev.Add(-step)
+			continue
+		}
+		if ok, err := gapDue(segs, prev, step, due); ok || err != nil {
+			return prev, ok, err
+		}
+		if pos == PosDayOfMonth && shortMonth(segs, prev) {
+			pos = PosMonth
+		}
+
+		bumped, ok := prev, false
+		if jump != nil {
+			bumped, ok = jump(prev, pos, step)
+		}
+		if !ok {
+			if bumped, err = bumpPrev(prev, pos, step); err != nil {
+				return bumped, false, err
+			}
+		}
+		if !bumped.Before(prev) {
+			return limit, false, &SearchError{Expr: strings.Join(segs, " "), Ref: ref, Year: prev.Year(), Prev: true}
+		}
+		// The wall clock times skipped by DST gap are due at the end of it.
+		if gap, ok := gapBetween(bumped, prev, step); ok && !gap.Before(limit) {
+			if ok, err := gapDue(segs, gap, step, due); ok || err != nil {
+				return gap, ok, err
+			}
+		}
+		prev = bumped
+	}
+
+	return limit, false, nil
+}
+
+// nextSignificant is the position of the segment whose period contains that of the segment at
+// the position, as in 1 hour has 60 minutes.
+var nextSignificant = [...]int{PosHour, PosDayOfMonth, PosMonth, PosYear, PosDayOfMonth, PosYear, PosMinute}
+
+// bumpPrev moves ref to the last minute (or second, as per step) of previous period of the
+// segment at given position. It returns error if the position is unknown.
+func bumpPrev(ref time.Time, pos int, step time.Duration) (time.Time, error) {
+	loc := ref.Location()
+	switch pos {
+	case PosYear:
+		return dayStart(ref.Year(), time.January, 1, loc).Add(-step), nil
+	case PosMonth:
+		return dayStart(ref.Year(), ref.Month(), 1, loc).Add(-step), nil
+	case PosDayOfMonth, PosDayOfWeek:
+		return dayStart(ref.Year(), ref.Month(), ref.Day(), loc).Add(-step), nil
+	case PosHour:
+		return truncMinute(ref).Add(-time.Duration(ref.Minute())*time.Minute - step), nil
+	case PosMinute:
+		return truncMinute(ref).Add(-step), nil
+	case PosSecond:
+		return ref.Add(-step), nil
+	}
+
+	return ref, posErr(pos)
+}
+
+// TimeSincePrev gets the duration since the previous due time of given cron expr until
+// reference time (or now), in whole seconds. It is 0 if the reference time is exactly on
+// a due minute. It returns error if the expr is invalid or has never been due.
+func (g *Gronx) TimeSincePrev(expr string, ref ...time.Time) (time.Duration, error) {
+	start := time.Now()
+	if len(ref) > 0 {
+		start = ref[0]
+	}
+
+	prev, err := g.GetPrev(expr, start)
+	if err != nil {
+		return 0, err
+	}
+
+	return start.Truncate(time.Second).Sub(*prev), nil
+}
+
+// IsOverdue checks if a job with given cron expr last seen running at lastSeen is late as of
+// reference time (or now). It is late when the most recent due time whose grace period has
+// elapsed by reference time is after lastSeen. With zero grace, the due minute itself counts.
+func (g *Gronx) IsOverdue(expr string, lastSeen time.Time, grace time.Duration, ref ...time.Time) (bool, error) {
+	e, err := g.Parse(expr)
+	if err != nil {
+		return false, err
+	}
+	start := time.Now()
+	if len(ref) > 0 {
+		start = ref[0]
+	}
+
+	prev, ok, err := e.prevUntil(start.Add(-grace), lastSeen, g.check(e))
+	if err != nil || !ok {
+		return false, err
+	}
+
+	return lastSeen.Before(prev), nil
+}
@@ -0,0 +1,416 @@
+package gronx
+
+import (
+	"errors"
+	"math/rand"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestGetPrev(t *testing.T) {
+	gron := New()
+
+	for _, test := range prevcases() {
+		t.Run("get prev "+test.Expr+" "+test.Ref, func(t *testing.T) {
+			prev, err := gron.GetPrev(test.Expr, test.ref())
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			if actual := prev.Format(dateFormat); actual != test.Expect {
+				t.Errorf("expected %v, got %v", test.Expect, actual)
+			}
+		})
+	}
+
+	for _, test := range preverrcases() {
+		t.Run("get prev err "+test.Expr, func(t *testing.T) {
+			prev, err := gron.GetPrev(test.Expr, test.ref())
+			if err == nil {
+				t.Errorf("expected error, got %v", prev)
+			}
+			if prev != nil {
+				t.Errorf("expected nil, got %v", prev)
+			}
+		})
+	}
+}
+
+func TestGetPrevN(t *testing.T) {
+	gron := New()
+
+	t.Run("get prev n across year", func(t *testing.T) {
+		ref, _ := time.Parse(dateFormat, "2022-01-01 00:30:00")
+		times, err := gron.GetPrevN("0 */12 * * *", ref, 3)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		expect := []string{"2022-01-01 00:00:00", "2021-12-31 12:00:00", "2021-12-31 00:00:00"}
+		if len(times) != len(expect) {
+			t.Fatalf("expected %d times, got %d", len(expect), len(times))
+		}
+		for i, prev := range times {
+			if actual := prev.Format(dateFormat); actual != expect[i] {
+				t.Errorf("expected %v, got %v", expect[i], actual)
+			}
+		}
+	})
+
+	t.Run("get prev n year limited", func(t *testing.T) {
+		ref, _ := time.Parse(dateFormat, "2030-01-01 00:00:00")
+		times, err := gron.GetPrevN("0 0 1 1,7 * 2020", ref, 5)
+		if err == nil {
+			t.Errorf("expected error, got nil")
+		}
+		if len(times) != 2 {
+			t.Fatalf("expected 2 times, got %d", len(times))
+		}
+		if actual := times[1].Format(dateFormat); actual != "2020-01-01 00:00:00" {
+			t.Errorf("expected 2020-01-01 00:00:00, got %v", actual)
+		}
+	})
+
+	t.Run("get prev n invalid", func(t *testing.T) {
+		if _, err := gron.GetPrevN("* * *", time.Now(), 5); err == nil {
+			t.Errorf("expected error, got nil")
+		}
+	})
+}
+
+func prevcases() []NextCase {
+	return []NextCase{
+		{"* * * * *", "2021-04-19 12:54:09", "2021-04-19 12:54:00"},
+		{"*/5 * * * *", "2021-04-19 12:54:00", "2021-04-19 12:50:00"},
+		{"0 * * * *", "2021-04-20 00:59:00", "2021-04-20 00:00:00"},
+		{"59 23 * * *", "2021-04-20 00:00:00", "2021-04-19 23:59:00"},
+		{"@daily", "2021-05-01 00:00:00", "2021-05-01 00:00:00"},
+		{"@monthly", "2021-03-15 10:00:00", "2021-03-01 00:00:00"},
+		{"@yearly", "2021-12-31 23:59:00", "2021-01-01 00:00:00"},
+		{"@quarterly", "2021-01-01 00:00:00", "2021-01-01 00:00:00"},
+		{"@quarterly", "2021-03-31 23:59:00", "2021-01-01 00:00:00"},
+		{"@quarterly", "2021-06-30 00:00:00", "2021-04-01 00:00:00"},
+		{"@quarterly", "2021-09-01 00:00:00", "2021-07-01 00:00:00"},
+		{"@quarterly", "2022-01-01 00:00:00", "2022-01-01 00:00:00"},
+		{"@quarterly", "2021-12-31 00:00:00", "2021-10-01 00:00:00"},
+		{"@midnight", "2021-05-01 12:00:00", "2021-05-01 00:00:00"},
+		{"0 0 * * 7", "2021-04-19 00:00:00", "2021-04-18 00:00:00"},
+		{"0 22-2 * * *", "2021-04-19 21:00:00", "2021-04-19 02:00:00"},
+		{"5/10 * * * *", "2021-04-19 10:03:00", "2021-04-19 09:55:00"},
+		{"0 0 1 1 * 2025,2027,2031", "2030-12-31 00:00:00", "2027-01-01 00:00:00"},
+		{"0 0 1 1 * 2025,2027,2031", "2099-12-31 00:00:00", "2031-01-01 00:00:00"},
+		{"0 0 1 1 * 2010-2015", "2021-04-19 00:00:00", "2015-01-01 00:00:00"},
+		{"0 0 1 1 * 2024/2", "2029-01-01 00:00:00", "2028-01-01 00:00:00"},
+		{"0 22-2/2 * * *", "2021-04-19 01:00:00", "2021-04-19 00:00:00"},
+		{"0 9 * * FRI-MON", "2021-04-22 12:00:00", "2021-04-19 09:00:00"},
+		{"0 0 * * 6-7", "2021-04-22 00:00:00", "2021-04-18 00:00:00"},
+		{"@weekend", "2021-04-19 00:00:00", "2021-04-18 00:00:00"},
+		{"@weekday", "2021-04-18 00:00:00", "2021-04-16 00:00:00"},
+		{"0 0 31 * *", "2021-05-01 00:00:00", "2021-03-31 00:00:00"},
+		{"0 0 29 2 *", "2025-03-01 00:00:00", "2024-02-29 00:00:00"},
+		{"0 0 L * *", "2024-03-15 00:00:00", "2024-02-29 00:00:00"},
+		{"0 0 L * *", "2023-03-15 00:00:00", "2023-02-28 00:00:00"},
+		{"0 0 L 2 *", "2000-12-31 00:00:00", "2000-02-29 00:00:00"},
+		{"0 18 * * 5L", "2021-05-27 00:00:00", "2021-04-30 18:00:00"},
+		{"0 0 31W * *", "2021-11-15 00:00:00", "2021-10-29 00:00:00"},
+		{"0 0 15W * *", "2021-08-16 00:00:00", "2021-08-16 00:00:00"},
+		{"0 17 LW * *", "2021-11-01 00:00:00", "2021-10-29 17:00:00"},
+		{"0 9 * * MON#5", "2021-08-01 00:00:00", "2021-05-31 09:00:00"},
+		{"0 0 L-3 * *", "2024-03-01 00:00:00", "2024-02-26 00:00:00"},
+		{"0 0 1 1 * 2020", "2021-04-19 12:54:09", "2020-01-01 00:00:00"},
+	}
+}
+
+func preverrcases() []NextCase {
+	return []NextCase{
+		{"* * * *", "2021-04-19 12:54:09", ""},
+		{"* * * * * 2030", "2021-04-19 12:54:09", ""},
+		{"0 0 30 2 *", "2021-04-19 12:54:09", ""},
+	}
+}
+
+func TestTimeSincePrev(t *testing.T) {
+	gron := New()
+	tests := []struct {
+		expr, ref string
+		expect    time.Duration
+	}{
+		{"5 10 * * *", "2021-04-19 10:05:00", 0},
+		{"5 10 * * *", "2021-04-19 10:05:37", 37 * time.Second},
+		{"5 10 * * *", "2021-04-19 10:04:37", 24*time.Hour - 23*time.Second},
+		{"0 0 1 1 *", "2022-01-01 01:00:00", time.Hour},
+	}
+
+	for _, test := range tests {
+		t.Run("time since prev "+test.expr+" "+test.ref, func(t *testing.T) {
+			ref, _ := time.Parse(dateFormat, test.ref)
+			actual, err := gron.TimeSincePrev(test.expr, ref.Add(999*time.Millisecond))
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if actual != test.expect {
+				t.Errorf("expected %v, got %v", test.expect, actual)
+			}
+		})
+	}
+
+	for _, expr := range []string{"* * *", "* * * * * 2099", "0 0 30 2 *"} {
+		t.Run("time since prev err "+expr, func(t *testing.T) {
+			if _, err := gron.TimeSincePrev(expr); err == nil {
+				t.Errorf("expected error, got nil")
+			}
+		})
+	}
+}
+
+func TestIsOverdue(t *testing.T) {
+	gron := New()
+	tests := []struct {
+		expr, lastSeen, ref string
+		grace               time.Duration
+		expect              bool
+	}{
+		{"0 * * * *", "2021-04-19 10:00:05", "2021-04-19 10:59:00", 0, false},
+		{"0 * * * *", "2021-04-19 10:00:05", "2021-04-19 11:00:00", 0, true},
+		{"0 * * * *", "2021-04-19 10:00:05", "2021-04-19 11:04:59", 5 * time.Minute, false},
+		{"0 * * * *", "2021-04-19 10:00:05", "2021-04-19 11:05:00", 5 * time.Minute, true},
+		{"0 * * * *", "2021-04-19 11:01:00", "2021-04-19 11:05:00", 5 * time.Minute, false},
+		{"0 0 1 1 *", "2021-04-19 10:00:00", "2021-12-31 23:59:00", 0, false},
+		{"0 0 1 1 * 2020", "2021-04-19 10:00:00", "2025-01-01 00:00:00", 0, false},
+	}
+
+	for _, test := range tests {
+		t.Run("is overdue "+test.expr+" "+test.ref, func(t *testing.T) {
+			lastSeen, _ := time.Parse(dateFormat, test.lastSeen)
+			ref, _ := time.Parse(dateFormat, test.ref)
+
+			actual, err := gron.IsOverdue(test.expr, lastSeen, test.grace, ref)
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if actual != test.expect {
+				t.Errorf("expected %v, got %v", test.expect, actual)
+			}
+		})
+	}
+
+	t.Run("is overdue now", func(t *testing.T) {
+		if actual, err := gron.IsOverdue("@always", time.Now().Add(-time.Hour), 0); err != nil || !actual {
+			t.Errorf("expected true, got %v, %v", actual, err)
+		}
+	})
+
+	t.Run("is overdue invalid", func(t *testing.T) {
+		if _, err := gron.IsOverdue("* * *", time.Now(), 0); err == nil {
+			t.Errorf("expected error, got nil")
+		}
+	})
+}
+
+func TestBumpUnknownPos(t *testing.T) {
+	ref, _ := time.Parse(dateFormat, "2021-04-19 10:00:00")
+	for _, pos := range []int{-1, 7, 9} {
+		t.Run("bump prev "+strconv.Itoa(pos), func(t *testing.T) {
+			if _, err := bumpPrev(ref, pos, time.Minute); err == nil {
+				t.Errorf("expected error, got nil")
+			}
+			if _, err := bumpNext(ref, pos, time.Minute); err == nil {
+				t.Errorf("expected error, got nil")
+			}
+		})
+	}
+
+	t.Run("check due unknown pos", func(t *testing.T) {
+		checker := SegmentChecker{}
+		if _, err := checker.CheckDue("0", 9, ref); err == nil || !strings.Contains(err.Error(), "invalid segment position 9") {
+			t.Errorf("expected invalid segment position error, got %v", err)
+		}
+	})
+}
+
+func TestGetPrevUnsatisfiable(t *testing.T) {
+	gron := New()
+	ref, _ := time.Parse(dateFormat, "2025-03-10 00:00:00")
+	for _, expr := range []string{"0 0 31 2 *", "0 0 30 2 *", "0 0 31 4,6,9,11 *", "0 0 29 2 * 2025", "0 0 1 1 1 2025"} {
+		t.Run("prev unsatisfiable "+expr, func(t *testing.T) {
+			if _, err := gron.GetPrev(expr, ref); !errors.Is(err, ErrNoOccurrence) {
+				t.Errorf("expected ErrNoOccurrence, got %v", err)
+			}
+			if _, err := PrevTick(expr, ref); !errors.Is(err, ErrNoOccurrence) {
+				t.Errorf("expected ErrNoOccurrence, got %v", err)
+			}
+		})
+	}
+
+	t.Run("prev satisfiable 0 0 29 2 *", func(t *testing.T) {
+		prev, err := gron.GetPrev("0 0 29 2 *", ref)
+		if err != nil || prev.Format(dateFormat) != "2024-02-29 00:00:00" {
+			t.Errorf("expected 2024-02-29 00:00:00, got %v, %v", prev, err)
+		}
+	})
+}
+
+func BenchmarkGetPrevUnsatisfiable(b *testing.B) {
+	gron := New()
+	ref, _ := time.Parse(dateFormat, "2025-03-10 00:00:00")
+	for _, expr := range []string{"0 0 31 2 *", "0 0 29 2 * 2025"} {
+		b.Run(expr, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_, _ = gron.GetPrev(expr, ref)
+			}
+		})
+	}
+}
+
+func TestGetPrevLocation(t *testing.T) {
+	refs := map[string][]string{
+		"America/New_York":    {"2024-03-10 04:00:00", "2024-11-03 03:00:00", "2024-11-03 01:45:00"},
+		"Europe/Berlin":       {"2024-03-31 03:10:00", "2024-10-27 02:45:00"},
+		"Australia/Lord_Howe": {"2024-04-07 02:10:00", "2024-10-06 02:40:00"},
+		"Asia/Kolkata":        {"2024-01-01 00:10:00"},
+		"UTC":                 {"2024-06-30 23:59:59"},
+	}
+	exprs := []string{"30 1 * * *", "15 2 * * *", "0 */2 * * *", "*/20 * * * *", "0 0 1 * *", "@every 90m"}
+	gron := New()
+
+	for zone, values := range refs {
+		loc, err := time.LoadLocation(zone)
+		abort(err)
+		zoned := New(WithLocation(loc))
+
+		for _, value := range values {
+			ref, _ := time.ParseInLocation(dateFormat, value, loc)
+			for _, expr := range exprs {
+				t.Run("get prev location "+zone+" "+expr+" "+value, func(t *testing.T) {
+					prev, err := gron.GetPrev(expr, ref)
+					if err != nil {
+						t.Fatalf("expected no error, got %v", err)
+					}
+					if prev.Location() != ref.Location() || prev.After(ref) {
+						t.Errorf("expected on or before %v in %s, got %v", ref, zone, prev)
+					}
+					if due, err := gron.IsDue(expr, *prev); !strings.HasPrefix(expr, "@") && (err != nil || !due) {
+						t.Errorf("expected due at %v, got %v, %v", prev, due, err)
+					}
+
+					utc, err := zoned.GetPrev(expr, ref.UTC())
+					if err != nil || !utc.Equal(*prev) || utc.Location().String() != zone {
+						t.Errorf("expected %v for utc ref, got %v, %v", prev, utc, err)
+					}
+					if after := prev.UTC().In(loc); after.Format(time.RFC3339Nano) != prev.Format(time.RFC3339Nano) {
+						t.Errorf("expected %v after utc round trip, got %v", prev, after)
+					}
+				})
+			}
+		}
+	}
+}
+
+func TestGetPrevJump(t *testing.T) {
+	rnd := rand.New(rand.NewSource(82))
+	zones := []string{"UTC", "America/New_York", "Europe/Berlin", "Australia/Lord_Howe", "America/Havana"}
+	grons := []Gronx{New(), New(WithDayOr()), New(WithSecondsField())}
+	ref := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+	jumped := 0
+
+	for i := 0; i < 3000; i++ {
+		gron := grons[i%len(grons)]
+		segs := make([]string, 5)
+		if gron.seconds {
+			segs = append(segs, "*")
+		}
+		for pos := range segs {
+			at := pos
+			if gron.seconds {
+				at = []int{PosSecond, PosMinute, PosHour, PosDayOfMonth, PosMonth, PosDayOfWeek}[pos]
+			}
+			if rnd.Intn(3) == 0 {
+				segs[pos] = "*"
+				continue
+			}
+			min, max := valueBounds(at)
+			offsets := make([]string, 1+rnd.Intn(2))
+			for j := range offsets {
+				offsets[j] = randomOffset(rnd, min, max)
+			}
+			segs[pos] = strings.Join(offsets, ",")
+		}
+		if rnd.Intn(10) == 0 {
+			segs[len(segs)-3] = "L"
+		}
+
+		expr := strings.Join(segs, " ")
+		e, err := gron.Parse(expr)
+		if err != nil {
+			continue
+		}
+		loc, err := time.LoadLocation(zones[rnd.Intn(len(zones))])
+		abort(err)
+		at := ref.Add(time.Duration(rnd.Int63n(int64(4 * 365 * 24 * time.Hour)))).In(loc)
+
+		expect, eerr := e.prev(at, e.check)
+		actual, err := e.prev(at, nil)
+		if !actual.Equal(expect) || (err == nil) != (eerr == nil) {
+			t.Errorf("%s at %v: expected %v, %v, got %v, %v", expr, at, expect, eerr, actual, err)
+		}
+		if err == nil {
+			jumped++
+		}
+	}
+	if jumped < 1000 {
+		t.Errorf("expected at least 1000 found, got %d", jumped)
+	}
+}
+
+func TestGetPrevJumpDST(t *testing.T) {
+	gron := New()
+	changes := []string{"2024-03-10 06:00:00", "2024-11-03 06:00:00", "2025-03-09 06:00:00", "2025-11-02 06:00:00"}
+	exprs := []string{}
+	for _, hour := range []string{"*", "0", "1", "2", "1,3", "23"} {
+		for _, day := range []string{"*", "2", "3", "9", "2,9", "10"} {
+			for _, month := range []string{"*", "3", "3,11"} {
+				exprs = append(exprs, "30 "+hour+" "+day+" "+month+" *", "0 "+hour+" "+day+" "+month+" *")
+			}
+		}
+	}
+
+	for _, zone := range []string{"America/New_York", "America/Havana"} {
+		loc, err := time.LoadLocation(zone)
+		abort(err)
+		for _, change := range changes {
+			mid, _ := time.Parse(dateFormat, change)
+			for at := mid.Add(-36 * time.Hour); at.Before(mid.Add(36 * time.Hour)); at = at.Add(150 * time.Minute) {
+				for _, expr := range exprs {
+					e, err := gron.Parse(expr)
+					abort(err)
+
+					expect, eerr := e.prev(at.In(loc), e.check)
+					actual, err := e.prev(at.In(loc), nil)
+					if !actual.Equal(expect) || (err == nil) != (eerr == nil) {
+						t.Errorf("%s at %v: expected %v, %v, got %v, %v", expr, at.In(loc), expect, eerr, actual, err)
+					}
+				}
+			}
+		}
+	}
+}
+
+func BenchmarkGetPrevSparse(b *testing.B) {
+	ref, _ := time.Parse(dateFormat, "2025-12-10 00:00:00")
+	for _, expr := range []string{"0 0 1 1 *", "0 3 29 2 *"} {
+		e, err := Parse(expr)
+		abort(err)
+		b.Run("jump "+expr, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_, _ = e.prev(ref, nil)
+			}
+		})
+		b.Run("bump "+expr, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_, _ = e.prev(ref, e.check)
+			}
+		})
+	}
+}
@@ -0,0 +1,172 @@
+package gronx
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// quartzFields translates week day of Quartz fields (seconds first) to cron numbers.
+func quartzFields(fields []string) ([]string, error) {
+	if strings.Contains(fields[0], "?") {
+		err := errors.New("? is only allowed in day of month or week day, not in second segment: " + fields[0])
+		return nil, fieldErr(PosSecond, fields[0], ReasonQuestionMark, err)
+	}
+
+	dow, err := quartzWeekday(fields[5])
+	if err != nil {
+		return nil, err
+	}
+
+	return append(append(fields[:5:5], dow), fields[6:]...), nil
+}
+
+// quartzWeekday translates week day segment from Quartz (1 is sunday, 7 is saturday) to cron numbers.
+func quartzWeekday(segment string) (string, error) {
+	if strings.EqualFold(segment, "L") {
+		return "6", nil
+	}
+
+	return mapWeekdays(segment, func(day int) (int, error) {
+		if day < 1 || day > 7 {
+			err := errors.New("week day should be 1-7 in Quartz: " + segment)
+			return 0, fieldErr(PosDayOfWeek, segment, ReasonOutOfRange, err)
+		}
+		return day - 1, nil
+	})
+}
+
+// cronWeekday translates week day segment from cron numbers (0 or 7 is sunday) to Quartz.
+func cronWeekday(segment string) (string, error) {
+	return mapWeekdays(strings.Replace(segment, "0-7", "0-6", -1), func(day int) (int, error) {
+		return day%7 + 1, nil
+	})
+}
+
+// mapWeekdays maps the numeric week days of segment with fn, leaving names, steps and nth as is.
+func mapWeekdays(segment string, fn func(int) (int, error)) (string, error) {
+	offsets := strings.Split(segment, ",")
+	for i, offset := range offsets {
+		value, suffix := offset, ""
+		if pos := strings.IndexAny(offset, "/#"); pos >= 0 {
+			value, suffix = offset[:pos], offset[pos:]
+		}
+
+		days := strings.Split(value, "-")
+		for j, day := range days {
+			last := strings.HasSuffix(strings.ToUpper(day), "L")
+			num, err := strconv.Atoi(strings.TrimSuffix(strings.ToUpper(day), "L"))
+			if err != nil {
+				continue
+			}
+			if num, err = fn(num); err != nil {
+				return "", err
+			}
+			if days[j] = strconv.Itoa(num); last {
+				days[j] += "L"
+			}
+		}
+		offsets[i] = strings.Join(days, "-") + suffix
+	}
+
+	return strings.Join(offsets, ","), nil
+}
+
+// FromQuartz converts Quartz cron expr (6 or 7 segments, seconds first) to cron expr of 5 segments,
+// or 6 if it has year. The seconds should be 0 as cron is due by minute, and ? should be strict
+// as with WithStrict. Macros are kept as is.
+func FromQuartz(expr string) (string, error) {
+	expr = strings.Trim(expr, " \t")
+	if _, ok := macro(expr); ok {
+		return expr, nil
+	}
+
+	fields := SpaceRe.Split(expr, -1)
+	if len(fields) != 6 && len(fields) != 7 {
+		return "", kindErr(ErrSegmentCount, "expr should contain 6 or 7 segments separated by space with seconds first")
+	}
+	if fields[0] != "0" {
+		return "", errors.New("seconds should be 0 to convert from Quartz: " + fields[0])
+	}
+
+	fields, err := quartzFields(fields)
+	if err != nil {
+		return "", err
+	}
+
+	expr = strings.Join(fields[1:], " ")
+	segs, err := Segments(expr)
+	if err != nil {
+		return "", err
+	}
+	if err := strictDays(segs); err != nil {
+		return "", err
+	}
+
+	return expr, nil
+}
+
+// ToQuartz converts cron expr (5 segments, or 6 with year) to Quartz cron expr with 0th second,
+// ? in whichever of day of month and week day is unrestricted and week days numbered from 1
+// (sunday). Macros are expanded. It errors if both day of month and week day are restricted,
+// because Quartz needs one of them to be ?.
+func ToQuartz(expr string) (string, error) {
+	expr = strings.Trim(expr, " \t")
+	if exp, ok := macro(expr); ok {
+		expr = exp
+	}
+	if _, err := Segments(expr); err != nil {
+		return "", err
+	}
+
+	fields := SpaceRe.Split(expr, -1)
+	switch dom, dow := fields[2], fields[4]; {
+	case dow == "*" || dow == "?":
+		fields[4] = "?"
+	case dom == "*" || dom == "?":
+		fields[2] = "?"
+	default:
+		return "", errors.New("day of month and week day can't both be restricted in Quartz: " + expr)
+	}
+
+	dow, err := cronWeekday(fields[4])
+	if err != nil {
+		return "", err
+	}
+	fields[4] = dow
+
+	return "0 " + strings.Join(fields, " "), nil
+}
+
+// strictDays checks that ? is only in day of month or week day, and that if one of them is
+// restricted the other one is ?.
+func strictDays(segs []string) error {
+	for pos, seg := range segs {
+		if pos != PosDayOfMonth && pos != PosDayOfWeek && strings.Contains(seg, "?") {
+			err := fmt.Errorf("? is only allowed in day of month or week day, not in %s segment: %s", segmentNames[pos], seg)
+			return fieldErr(pos, seg, ReasonQuestionMark, err)
+		}
+	}
+
+	dom, dow := segs[PosDayOfMonth], segs[PosDayOfWeek]
+	for pos, seg := range map[int]string{PosDayOfMonth: dom, PosDayOfWeek: dow} {
+		if seg != "?" && strings.Contains(seg, "?") {
+			return fieldErr(pos, seg, ReasonQuestionMark, errors.New("? should be alone in its segment: "+seg))
+		}
+	}
+
+	switch {
+	case dom == "?" && dow == "?":
+		err := errors.New("? should not be in both day of month and week day")
+		return fieldErr(PosDayOfWeek, dow, ReasonQuestionMark, err)
+	case dom != "*" && dom != "?" && dow != "?":
+		err := errors.New("week day should be ? when day of month is restricted: " + dom + " " + dow)
+		return fieldErr(PosDayOfWeek, dow, ReasonQuestionMark, err)
+	case dow != "*" && dow != "?" && dom != "?":
+		err := errors.New("day of month should be ? when week day is restricted: " + dom + " " + dow)
+		return fieldErr(PosDayOfMonth, dom, ReasonQuestionMark, err)
+	}
+
+	return nil
+}
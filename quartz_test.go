@@ -0,0 +1,155 @@
+package gronx
+
+import (
+	"testing"
+	"time"
+)
+
+func TestWithQuartz(t *testing.T) {
+	gron := New(WithQuartz())
+	tests := []Case{
+		{"0 15 10 ? * MON-FRI", "2021-04-19 10:15:00", true},
+		{"0 15 10 ? * MON-FRI", "2021-04-18 10:15:00", false},
+		{"0 15 10 ? * MON-FRI 2021-2030", "2021-04-19 10:15:00", true},
+		{"0 15 10 ? * MON-FRI 2025-2030", "2021-04-19 10:15:00", false},
+		{"0 15 10 ? * 2-6", "2021-04-19 10:15:00", true},
+		{"0 15 10 ? * 2-6", "2021-04-18 10:15:00", false},
+		{"0 0 0 ? * 1", "2021-04-18 00:00:00", true},
+		{"0 0 0 ? * 7", "2021-04-17 00:00:00", true},
+		{"0 0 0 ? * L", "2021-04-17 00:00:00", true},
+		{"0 0 0 ? * 7-1", "2021-04-18 00:00:00", true},
+		{"0 0 0 ? * 7-1", "2021-04-19 00:00:00", false},
+		{"0 0 0 ? * 2#3", "2021-04-19 00:00:00", true},
+		{"0 0 0 ? * 2L", "2021-04-26 00:00:00", true},
+		{"0 0 0 ? * 2L", "2021-04-19 00:00:00", false},
+		{"0 0 0 ? * */2", "2021-04-18 00:00:00", true},
+		{"0 0 0 ? * */2", "2021-04-19 00:00:00", false},
+		{"0 0 0 L * ?", "2021-04-30 00:00:00", true},
+		{"0 0 0 LW * ?", "2021-04-30 00:00:00", true},
+		{"0 0 0 15W * ?", "2021-08-16 00:00:00", true},
+		{"30 * * * * ?", "2021-04-19 10:05:30", true},
+		{"@weekly", "2021-04-18 00:00:00", true},
+	}
+
+	for _, test := range tests {
+		t.Run("quartz "+test.Expr+" "+test.Ref, func(t *testing.T) {
+			actual, err := test.run(gron)
+			if err != nil || actual != test.Expect {
+				t.Errorf("expected %v, got %v, %v", test.Expect, actual, err)
+			}
+		})
+	}
+
+	for _, expr := range []string{"0 15 10 * *", "0 15 10 ? * * 2025 1", "0 ? 10 * * *", "? 15 10 * * *", "0 0 0 ? * 0", "0 0 0 ? * 8", "0 0 0 ? * 1-8", "0 0 0 ? * * 1969"} {
+		t.Run("quartz err "+expr, func(t *testing.T) {
+			if gron.IsValid(expr) {
+				t.Errorf("expected false, got true")
+			}
+		})
+	}
+
+	t.Run("quartz prev next", func(t *testing.T) {
+		ref, _ := time.Parse(dateFormat, "2021-04-19 12:00:00")
+		next, err := gron.GetNext("0 15 10 ? * MON-FRI 2025-2030", ref)
+		if err != nil || next.Format(dateFormat) != "2025-01-01 10:15:00" {
+			t.Errorf("expected 2025-01-01 10:15:00, got %v, %v", next, err)
+		}
+		prev, err := gron.GetPrev("15 0 0 ? * 1", ref)
+		if err != nil || prev.Format(dateFormat) != "2021-04-18 00:00:15" {
+			t.Errorf("expected 2021-04-18 00:00:15, got %v, %v", prev, err)
+		}
+	})
+}
+
+func TestFromQuartz(t *testing.T) {
+	tests := map[string]string{
+		"0 15 10 ? * MON-FRI":      "15 10 ? * MON-FRI",
+		"0 15 10 ? * 2-6 2025":     "15 10 ? * 1-5 2025",
+		"0 0 0 ? * 1,7":            "0 0 ? * 0,6",
+		"0 0 0 ? * 7-1":            "0 0 ? * 6-0",
+		"0 0 0 ? * 6L":             "0 0 ? * 5L",
+		"0 0 0 ? * 2#3":            "0 0 ? * 1#3",
+		"0 0 0 ? * 1/2":            "0 0 ? * 0/2",
+		"0 0 0 ? * L":              "0 0 ? * 6",
+		"0 0 12 L-3 * ?":           "0 12 L-3 * ?",
+		"@daily":                   "@daily",
+		"  0 */5 * * * ?  ":        "*/5 * * * ?",
+		"0 0 0 ? JAN-JUN/2 SAT,1":  "0 0 ? JAN-JUN/2 SAT,0",
+		"0 0 0 ? * MON#2,3 2025/2": "0 0 ? * MON#2,2 2025/2",
+	}
+
+	for expr, expect := range tests {
+		t.Run("from quartz "+expr, func(t *testing.T) {
+			actual, err := FromQuartz(expr)
+			if err != nil || actual != expect {
+				t.Errorf("expected %v, got %v, %v", expect, actual, err)
+			}
+		})
+	}
+
+	for _, expr := range []string{"0 0 * * *", "30 0 0 ? * *", "0 0 ? 0 * *", "0 0 0 ? * 0", "0 0 0 ? * * 2100"} {
+		t.Run("from quartz err "+expr, func(t *testing.T) {
+			if actual, err := FromQuartz(expr); err == nil {
+				t.Errorf("expected error, got %v", actual)
+			}
+		})
+	}
+}
+
+func TestToQuartz(t *testing.T) {
+	tests := map[string]string{
+		"15 10 * * MON-FRI": "0 15 10 ? * MON-FRI",
+		"15 10 * * 1-5":     "0 15 10 ? * 2-6",
+		"0 0 * * 0,6,7":     "0 0 0 ? * 1,7,1",
+		"0 0 * * 0-7":       "0 0 0 ? * 1-7",
+		"0 0 * * 5-7":       "0 0 0 ? * 6-1",
+		"0 0 * * 5L":        "0 0 0 ? * 6L",
+		"0 0 * * 1#3":       "0 0 0 ? * 2#3",
+		"0 0 * * */2":       "0 0 0 ? * */2",
+		"0 0 L * *":         "0 0 0 L * ?",
+		"0 0 1 1 * 2025":    "0 0 0 1 1 ? 2025",
+		"* * * * *":         "0 * * * * ?",
+		"@weekly":           "0 0 0 ? * 1",
+	}
+
+	for expr, expect := range tests {
+		t.Run("to quartz "+expr, func(t *testing.T) {
+			actual, err := ToQuartz(expr)
+			if err != nil || actual != expect {
+				t.Errorf("expected %v, got %v, %v", expect, actual, err)
+			}
+		})
+	}
+
+	for _, expr := range []string{"0 0 13 * 5", "* * * *", "@reboot"} {
+		t.Run("to quartz err "+expr, func(t *testing.T) {
+			if actual, err := ToQuartz(expr); err == nil {
+				t.Errorf("expected error, got %v", actual)
+			}
+		})
+	}
+
+	t.Run("quartz round trip", func(t *testing.T) {
+		gron, quartz := New(), New(WithQuartz())
+		ref, _ := time.Parse(dateFormat, "2021-04-19 00:00:00")
+		for _, expr := range []string{"0 9 * * 1-5", "0 0 * * 5-7", "0 0 * * 0#2", "0 0 L * *", "30 4 1,15 * *"} {
+			conv, err := ToQuartz(expr)
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			expect, _ := gron.GetNextN(expr, ref, 5)
+			actual, err := quartz.GetNextN(conv, ref, 5)
+			if err != nil || len(actual) != len(expect) {
+				t.Fatalf("expected %d times, got %v, %v", len(expect), actual, err)
+			}
+			for i := range expect {
+				if !actual[i].Equal(expect[i]) {
+					t.Errorf("expected %v, got %v for %s", expect[i], actual[i], conv)
+				}
+			}
+			if back, err := FromQuartz(conv); err != nil || !gron.IsValid(back) {
+				t.Errorf("expected valid cron from %s, got %v, %v", conv, back, err)
+			}
+		}
+	})
+}
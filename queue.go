@@ -0,0 +1,181 @@
+package gronx
+
+import (
+	"container/heap"
+	"sync"
+	"time"
+)
+
+// Queue orders the cron exprs added by id by their next due time, so that a scheduler can sleep
+// until the earliest one (Peek) and then take those due (PopDue). The popped ones are added back
+// by their next due time after that, where the ones that can't be due anymore are dropped.
+// It is safe for concurrent use: all methods hold the lock of Queue, except that the callback
+// for dropped ones is called after it is released, so it may use the Queue as well.
+type Queue struct {
+	mu    sync.Mutex
+	gron  Gronx
+	ref   time.Time
+	seq   uint64
+	items queueItems
+	byID  map[string]*queueItem
+	drop  func(id, expr string, err error)
+}
+
+// queueItem is the compiled expression of expr added by id, due next at.
+type queueItem struct {
+	id    string
+	expr  string
+	e     *Expression
+	at    time.Time
+	seq   uint64
+	index int
+}
+
+// NewQueue gives Queue of cron exprs checked as per gron, due on or after from. The drop func
+// if not nil is called for the id and expr that can't be due anymore, along with why.
+func NewQueue(gron Gronx, from time.Time, drop func(id, expr string, err error)) *Queue {
+	return &Queue{gron: gron, ref: from, byID: map[string]*queueItem{}, drop: drop}
+}
+
+// Add adds cron expr by id, replacing the one added by id before if any. It is due next on or
+// after the from time of Queue or the last now given to PopDue. It returns error if expr is
+// invalid, and drops it if it can't be due.
+func (q *Queue) Add(id, expr string) error {
+	e, err := q.gron.Parse(expr)
+	if err != nil {
+		return err
+	}
+
+	q.mu.Lock()
+	q.remove(id)
+	item := &queueItem{id: id, expr: expr, e: e, seq: q.seq}
+	q.seq++
+
+	at, err := e.next(q.ref, q.gron.check(e))
+	if err == nil {
+		item.at = at
+		q.byID[id] = item
+		heap.Push(&q.items, item)
+	}
+	q.mu.Unlock()
+
+	if err != nil && q.drop != nil {
+		q.drop(id, expr, err)
+	}
+
+	return nil
+}
+
+// Remove removes the cron expr added by id. It returns whether there was one.
+func (q *Queue) Remove(id string) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.remove(id)
+}
+
+func (q *Queue) remove(id string) bool {
+	item, ok := q.byID[id]
+	if ok {
+		heap.Remove(&q.items, item.index)
+		delete(q.byID, id)
+	}
+
+	return ok
+}
+
+// Len gives the count of cron exprs in Queue.
+func (q *Queue) Len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return len(q.items)
+}
+
+// Peek gives the id of cron expr due the earliest and its due time, or empty id and zero
+// time if Queue is empty. The ones due at the same time are in the order they were added.
+func (q *Queue) Peek() (string, time.Time) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if len(q.items) == 0 {
+		return "", time.Time{}
+	}
+
+	return q.items[0].id, q.items[0].at
+}
+
+// PopDue takes the ids of cron exprs due on or before now, in order of due time, with each of
+// them added back by its next due time after the minute (or second, as per expr) of now. So
+// the due times missed in between are run once, ie they are not due again for the same now.
+func (q *Queue) PopDue(now time.Time) []string {
+	type dropped struct {
+		item *queueItem
+		err  error
+	}
+
+	q.mu.Lock()
+	ids, drops := []string{}, []dropped{}
+	popped := []*queueItem{}
+	for len(q.items) > 0 && !q.items[0].at.After(now) {
+		item := heap.Pop(&q.items).(*queueItem)
+		ids, popped = append(ids, item.id), append(popped, item)
+	}
+	for _, item := range popped {
+		e, after := item.e, item.e.slot(now)
+		if !e.gron.exclusive {
+			// The next span is given as is, ie without excluding it again.
+			after = after.Add(e.span())
+		}
+		at, err := e.next(after, q.gron.check(e))
+		if err != nil {
+			delete(q.byID, item.id)
+			drops = append(drops, dropped{item, err})
+			continue
+		}
+		item.at = at
+		heap.Push(&q.items, item)
+	}
+	q.ref = now
+	q.mu.Unlock()
+
+	if q.drop != nil {
+		for _, d := range drops {
+			q.drop(d.item.id, d.item.expr, d.err)
+		}
+	}
+
+	return ids
+}
+
+// queueItems is min heap of queueItem by due time, then by the order added.
+type queueItems []*queueItem
+
+func (h queueItems) Len() int { return len(h) }
+
+func (h queueItems) Less(i, j int) bool {
+	if h[i].at.Equal(h[j].at) {
+		return h[i].seq < h[j].seq
+	}
+
+	return h[i].at.Before(h[j].at)
+}
+
+func (h queueItems) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].index, h[j].index = i, j
+}
+
+func (h *queueItems) Push(x interface{}) {
+	item := x.(*queueItem)
+	item.index = len(*h)
+	*h = append(*h, item)
+}
+
+func (h *queueItems) Pop() interface{} {
+	old := *h
+	item := old[len(old)-1]
+	*h = old[:len(old)-1]
+
+	return item
+}
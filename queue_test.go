@@ -0,0 +1,121 @@
+package gronx
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestQueue(t *testing.T) {
+	from := time.Date(2024, time.June, 3, 9, 0, 30, 0, time.UTC)
+
+	t.Run("queue order", func(t *testing.T) {
+		q := NewQueue(New(), from, nil)
+		for _, id := range []string{"hourly", "five", "ten", "half"} {
+			abort(q.Add(id, map[string]string{"hourly": "0 * * * *", "five": "*/5 * * * *", "ten": "10 9 * * *", "half": "30 9 * * *"}[id]))
+		}
+		if err := q.Add("bad", "61 * * * *"); err == nil {
+			t.Errorf("expected error, got nil")
+		}
+
+		expects := []struct {
+			now time.Time
+			ids []string
+		}{
+			{from, []string{"hourly", "five"}},
+			{from.Add(2 * time.Minute), []string{}},
+			{from.Add(10 * time.Minute), []string{"five", "ten"}},
+			{from.Add(10 * time.Minute), []string{}},
+			{from.Add(30 * time.Minute), []string{"five", "half"}},
+		}
+		for _, expect := range expects {
+			if ids := q.PopDue(expect.now); fmt.Sprint(ids) != fmt.Sprint(expect.ids) {
+				t.Errorf("%v: expected %v, got %v", expect.now, expect.ids, ids)
+			}
+		}
+		if id, at := q.Peek(); id != "five" || at.Format(dateFormat) != "2024-06-03 09:35:00" {
+			t.Errorf("expected five at 09:35, got %s at %v", id, at)
+		}
+	})
+
+	t.Run("queue peek", func(t *testing.T) {
+		q := NewQueue(New(), from, nil)
+		if id, at := q.Peek(); id != "" || !at.IsZero() {
+			t.Errorf("expected empty, got %s at %v", id, at)
+		}
+		abort(q.Add("a", "0 12 * * *"))
+		abort(q.Add("b", "0 10 * * *"))
+		abort(q.Add("c", "0 10 * * *"))
+		if id, at := q.Peek(); id != "b" || at.Format(dateFormat) != "2024-06-03 10:00:00" {
+			t.Errorf("expected b at 10:00, got %s at %v", id, at)
+		}
+
+		abort(q.Add("b", "0 11 * * *"))
+		if id, _ := q.Peek(); id != "c" || q.Len() != 3 {
+			t.Errorf("expected c with b replaced, got %s of %d", id, q.Len())
+		}
+		if !q.Remove("c") || q.Remove("c") {
+			t.Errorf("expected c removed once")
+		}
+		if id, at := q.Peek(); id != "b" || at.Format(dateFormat) != "2024-06-03 11:00:00" {
+			t.Errorf("expected b at 11:00, got %s at %v", id, at)
+		}
+	})
+
+	t.Run("queue missed", func(t *testing.T) {
+		q := NewQueue(New(), from, nil)
+		abort(q.Add("five", "*/5 * * * *"))
+		if ids := q.PopDue(from.Add(time.Hour)); len(ids) != 1 {
+			t.Errorf("expected missed ones once, got %v", ids)
+		}
+		if _, at := q.Peek(); at.Format(dateFormat) != "2024-06-03 10:05:00" {
+			t.Errorf("expected 10:05, got %v", at)
+		}
+		abort(q.Add("now", "0 10 * * *"))
+		if ids := q.PopDue(from.Add(time.Hour)); fmt.Sprint(ids) != "[now]" {
+			t.Errorf("expected added one due, got %v", ids)
+		}
+	})
+
+	t.Run("queue drop", func(t *testing.T) {
+		dropped := map[string]error{}
+		q := NewQueue(New(), from, func(id, expr string, err error) { dropped[id] = err })
+		abort(q.Add("once", "0 10 3 6 * 2024"))
+		abort(q.Add("never", "0 0 * * * 2023"))
+		abort(q.Add("daily", "0 10 * * *"))
+
+		if _, ok := dropped["never"]; !ok || q.Len() != 2 {
+			t.Errorf("expected never dropped, got %v", dropped)
+		}
+		if ids := q.PopDue(from.Add(time.Hour)); fmt.Sprint(ids) != "[once daily]" {
+			t.Errorf("expected [once daily], got %v", ids)
+		}
+		if _, ok := dropped["once"]; !ok || q.Len() != 1 {
+			t.Errorf("expected once dropped, got %v", dropped)
+		}
+	})
+
+	t.Run("queue concurrent", func(t *testing.T) {
+		q, wg := NewQueue(New(), from, nil), sync.WaitGroup{}
+		for i := 0; i < 8; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				for j := 0; j < 30; j++ {
+					id := fmt.Sprintf("%d-%d", i, j%5)
+					abort(q.Add(id, fmt.Sprintf("*/%d * * * *", 1+j%5)))
+					q.PopDue(from.Add(time.Duration(j) * time.Minute))
+					q.Peek()
+					if j%3 == 0 {
+						q.Remove(id)
+					}
+				}
+			}(i)
+		}
+		wg.Wait()
+		if n := q.Len(); n > 8*5 {
+			t.Errorf("expected at most 40, got %d", n)
+		}
+	})
+}
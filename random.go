@@ -0,0 +1,79 @@
+package gronx
+
+import (
+	"errors"
+	"math/rand"
+	"strconv"
+	"strings"
+)
+
+// resolveRandom replaces R and lo~hi offsets (either end optional) and their /n variants in
+// segment with values picked randomly from the seed. The pick is stable for given seed and segment.
+func resolveRandom(segment string, pos int, seed int64) (string, error) {
+	if pos == PosYear {
+		return "", errors.New("random value is not supported in year segment: " + segment)
+	}
+
+	rnd := rand.New(rand.NewSource(seed + int64(hashOf(segment, pos))))
+	offsets := strings.Split(segment, ",")
+	for i, offset := range offsets {
+		if offset == "R" {
+			offset = "~"
+		}
+		if !strings.Contains(offset, "~") {
+			continue
+		}
+
+		parts := strings.Split(offset, "/")
+		min, max, err := randomRange(parts[0], pos)
+		if err != nil {
+			return "", errors.New(err.Error() + " in cron segment: " + segment)
+		}
+		if len(parts) == 1 {
+			offsets[i] = strconv.Itoa(min + rnd.Intn(max-min+1))
+			continue
+		}
+
+		step, err := strconv.Atoi(parts[1])
+		if err != nil || step < 1 || len(parts) > 2 {
+			return "", errors.New("invalid step for ~ in cron segment: " + segment)
+		}
+		if step > max-min+1 {
+			step = max - min + 1
+		}
+		offsets[i] = strconv.Itoa(min+rnd.Intn(step)) + "-" + strconv.Itoa(max) + "/" + parts[1]
+	}
+
+	return strings.Join(offsets, ","), nil
+}
+
+// randomRange parses lo~hi validated against the bounds of segment at given position,
+// defaulting to the bounds for the missing ends.
+func randomRange(offset string, pos int) (int, int, error) {
+	min, max := bounds[pos][0], bounds[pos][1]
+	if offset == "~" && pos == 2 {
+		// Bare ~ in day of month stops at 28 so that it is due in every month.
+		return min, 28, nil
+	}
+
+	var err1, err2 error
+	lo, hi := min, max
+	parts := strings.Split(offset, "~")
+	if len(parts) != 2 {
+		return 0, 0, errors.New("~ range should be lo~hi")
+	}
+	if parts[0] != "" {
+		lo, err1 = strconv.Atoi(parts[0])
+	}
+	if parts[1] != "" {
+		hi, err2 = strconv.Atoi(parts[1])
+	}
+	if err1 != nil || err2 != nil {
+		return 0, 0, errors.New("~ range should be lo~hi")
+	}
+	if lo > hi || lo < min || hi > max {
+		return 0, 0, errors.New("~ range should be within " + strconv.Itoa(min) + "-" + strconv.Itoa(max) + " and not inverted")
+	}
+
+	return lo, hi, nil
+}
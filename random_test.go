@@ -0,0 +1,142 @@
+package gronx
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWithSeed(t *testing.T) {
+	t.Run("random stable within bounds", func(t *testing.T) {
+		for seed := int64(0); seed < 20; seed++ {
+			gron := New(WithSeed(seed))
+			segs, err := gron.segments("~ ~ ~ ~ ~")
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			other := New(WithSeed(seed))
+			again, _ := other.segments("~ ~ ~ ~ ~")
+			if strings.Join(segs, " ") != strings.Join(again, " ") {
+				t.Errorf("expected %v, got %v", segs, again)
+			}
+			for pos, seg := range segs {
+				max := bounds[pos][1]
+				if pos == 2 {
+					max = 28
+				}
+				if val := mustAtoi(t, seg); val < bounds[pos][0] || val > max {
+					t.Errorf("expected %s within %d-%d", seg, bounds[pos][0], max)
+				}
+			}
+		}
+	})
+
+	t.Run("random range", func(t *testing.T) {
+		for seed := int64(0); seed < 20; seed++ {
+			gron := New(WithSeed(seed))
+			segs, err := gron.segments("10~20 ~5 20~ * *")
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			for pos, bound := range [][2]int{{10, 20}, {0, 5}, {20, 31}} {
+				if val := mustAtoi(t, segs[pos]); val < bound[0] || val > bound[1] {
+					t.Errorf("expected %s within %v", segs[pos], bound)
+				}
+			}
+		}
+	})
+
+	t.Run("random step", func(t *testing.T) {
+		for seed := int64(0); seed < 20; seed++ {
+			gron := New(WithSeed(seed))
+			segs, _ := gron.segments("0~59/10 * * * *")
+			parts := strings.Split(segs[0], "-")
+			if phase := mustAtoi(t, parts[0]); phase > 9 || parts[1] != "59/10" {
+				t.Errorf("expected phase 0-9 with 59/10, got %s", segs[0])
+			}
+		}
+	})
+
+	t.Run("random seeds differ", func(t *testing.T) {
+		minutes := map[string]bool{}
+		for seed := int64(0); seed < 10; seed++ {
+			gron := New(WithSeed(seed))
+			segs, _ := gron.segments("~ * * * *")
+			minutes[segs[0]] = true
+		}
+		if len(minutes) < 2 {
+			t.Errorf("expected different minutes, got %v", minutes)
+		}
+	})
+
+	t.Run("random due", func(t *testing.T) {
+		gron := New(WithSeed(42))
+		segs, _ := gron.segments("~ * * * *")
+		ref, _ := time.Parse(dateFormat, "2021-04-19 10:00:00")
+		ref = ref.Add(time.Duration(mustAtoi(t, segs[0])) * time.Minute)
+
+		if due, err := gron.IsDue("~ * * * *", ref); err != nil || !due {
+			t.Errorf("expected true, got %v, %v", due, err)
+		}
+		if next, err := gron.GetNext("~ * * * *", ref.Add(time.Minute)); err != nil || !next.Equal(ref.Add(time.Hour)) {
+			t.Errorf("expected %v, got %v, %v", ref.Add(time.Hour), next, err)
+		}
+		if prev, err := gron.GetPrev("~ * * * *", ref.Add(-time.Minute)); err != nil || !prev.Equal(ref.Add(-time.Hour)) {
+			t.Errorf("expected %v, got %v, %v", ref.Add(-time.Hour), prev, err)
+		}
+	})
+
+	t.Run("random token", func(t *testing.T) {
+		for seed := int64(0); seed < 20; seed++ {
+			gron := New(WithSeed(seed))
+			segs, err := gron.segments("R 3 R,15 * *")
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if val := mustAtoi(t, segs[0]); val < 0 || val > 59 || segs[1] != "3" {
+				t.Errorf("expected random minute at 3, got %v", segs)
+			}
+			if !strings.HasSuffix(segs[2], ",15") {
+				t.Errorf("expected random day with 15, got %s", segs[2])
+			}
+		}
+	})
+
+	t.Run("random token agrees", func(t *testing.T) {
+		gron := New(WithSeed(7))
+		ref, _ := time.Parse(dateFormat, "2021-04-19 00:00:00")
+		next, err := gron.GetNext("R 3 * * *", ref)
+		if err != nil || next.Hour() != 3 {
+			t.Fatalf("expected 3 o'clock, got %v, %v", next, err)
+		}
+
+		if due, err := gron.IsDue("R 3 * * *", *next); err != nil || !due {
+			t.Errorf("expected true, got %v, %v", due, err)
+		}
+		if prev, err := gron.GetPrev("R 3 * * *", next.Add(24*time.Hour)); err != nil || !prev.Equal(next.Add(24*time.Hour)) {
+			t.Errorf("expected %v, got %v, %v", next.Add(24*time.Hour), prev, err)
+		}
+	})
+
+	gron := New(WithSeed(1))
+	for _, expr := range []string{"~ * * * *", "0~59/10 * * * *", "* 8~18 * * *", "1,~ * * * *"} {
+		t.Run("random valid "+expr, func(t *testing.T) {
+			if !gron.IsValid(expr) {
+				t.Errorf("expected true, got false")
+			}
+		})
+	}
+
+	for _, expr := range []string{
+		"20~10 * * * *", "0~60 * * * *", "* * 0~5 * *", "~~ * * * *", "a~b * * * *",
+		"~/0 * * * *", "~/x * * * *", "~/5/5 * * * *", "* * * * * ~", "0 0 1 1 * R", "RR * * * *",
+	} {
+		t.Run("random err "+expr, func(t *testing.T) {
+			if gron.IsValid(expr) {
+				t.Errorf("expected false, got true")
+			}
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package gronx
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Report is the result of ValidateAll, keyed by the index of expr: Errors of the invalid exprs
+// and Warnings from Lint for the valid ones that have them.
+type Report struct {
+	Errors   map[int]error
+	Warnings map[int][]Warning
+	exprs    []string
+}
+
+// ValidateAll checks each of exprs like Validate and lints the valid ones like Lint, without
+// stopping at the first invalid one. It has no shared state, so it is safe to call from many
+// goroutines. It returns Report.
+func ValidateAll(exprs []string) Report {
+	return validateAll(exprs, Validate, true)
+}
+
+// ValidateAll checks each of exprs as per the options of Gronx like the stateless ValidateAll.
+// The exprs with seconds are not linted. It is safe to call from many goroutines.
+func (g *Gronx) ValidateAll(exprs []string) Report {
+	return validateAll(exprs, g.Validate, !g.seconds)
+}
+
+// validateAll checks each of exprs with validate and lints the valid ones if lint is true.
+func validateAll(exprs []string, validate func(string) error, lint bool) Report {
+	r := Report{Errors: map[int]error{}, Warnings: map[int][]Warning{}, exprs: append([]string{}, exprs...)}
+	for i, expr := range exprs {
+		if err := validate(expr); err != nil {
+			r.Errors[i] = err
+			continue
+		}
+		if !lint {
+			continue
+		}
+		if warns := Lint(expr); len(warns) > 0 {
+			r.Warnings[i] = warns
+		}
+	}
+
+	return r
+}
+
+// Valid tells if none of the exprs has error, regardless of warnings.
+func (r Report) Valid() bool {
+	return len(r.Errors) == 0
+}
+
+// String gives the errors and warnings one per line in order of index, for logs of CI, eg:
+// `#2 "61 * * * *": error: value 61 in minute segment should be 0-59: 61`.
+// It is empty if there are none.
+func (r Report) String() string {
+	idx := make([]int, 0, len(r.Errors)+len(r.Warnings))
+	for i := range r.Errors {
+		idx = append(idx, i)
+	}
+	for i := range r.Warnings {
+		if _, ok := r.Errors[i]; !ok {
+			idx = append(idx, i)
+		}
+	}
+	sort.Ints(idx)
+
+	lines := []string{}
+	for _, i := range idx {
+		expr := ""
+		if i < len(r.exprs) {
+			expr = r.exprs[i]
+		}
+		if err, ok := r.Errors[i]; ok {
+			lines = append(lines, fmt.Sprintf("#%d %q: error: %s", i, expr, err))
+		}
+		for _, warn := range r.Warnings[i] {
+			lines = append(lines, fmt.Sprintf("#%d %q: warning: %s", i, expr, warn))
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,73 @@
+package gronx
+
+import (
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestValidateAll(t *testing.T) {
+	exprs := []string{"* * * * *", "61 * * * *", "0 0 13 * FRI", "@daily", "0 0 * * MOM", "0-59 0 * * *"}
+
+	t.Run("validate all", func(t *testing.T) {
+		report := ValidateAll(exprs)
+		if report.Valid() {
+			t.Errorf("expected invalid, got valid")
+		}
+		if len(report.Errors) != 2 || report.Errors[1] == nil || report.Errors[4] == nil {
+			t.Errorf("expected errors for #1 and #4, got %v", report.Errors)
+		}
+		if len(report.Warnings) != 2 || report.Warnings[2][0].Code != WarnDayAndWeekDay || report.Warnings[5][0].Code != WarnFullRange {
+			t.Errorf("expected warnings for #2 and #5, got %v", report.Warnings)
+		}
+	})
+
+	t.Run("validate all string", func(t *testing.T) {
+		lines := strings.Split(ValidateAll(exprs).String(), "\n")
+		if len(lines) != 4 {
+			t.Fatalf("expected 4 lines, got %v", lines)
+		}
+
+		expect := []string{
+			`#1 "61 * * * *": error: value 61 in minute segment should be 0-59: 61`,
+			`#2 "0 0 13 * FRI": warning: day-and-week-day: `,
+			`#4 "0 0 * * MOM": error: unknown name "MOM" in week day segment: MOM, did you mean MON?`,
+			`#5 "0-59 0 * * *": warning: full-range: `,
+		}
+		for i, line := range lines {
+			if !strings.HasPrefix(line, expect[i]) {
+				t.Errorf("expected %s, got %s", expect[i], line)
+			}
+		}
+	})
+
+	t.Run("validate all valid", func(t *testing.T) {
+		report := ValidateAll([]string{"0 0 * * *", "@hourly"})
+		if !report.Valid() || len(report.Warnings) != 0 || report.String() != "" {
+			t.Errorf("expected valid without warnings, got %v", report)
+		}
+	})
+
+	t.Run("validate all gronx", func(t *testing.T) {
+		gron := New(WithSecondsField())
+		report := gron.ValidateAll([]string{"0 * * * * *", "* * * * *", "@every 1h"})
+		if len(report.Errors) != 1 || report.Errors[1] == nil || len(report.Warnings) != 0 {
+			t.Errorf("expected error for #1 only, got %v", report)
+		}
+	})
+
+	t.Run("validate all concurrent", func(t *testing.T) {
+		gron := New()
+		wg := sync.WaitGroup{}
+		for i := 0; i < 8; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				if report := gron.ValidateAll(exprs); len(report.Errors) != 2 {
+					t.Errorf("expected 2 errors, got %v", report.Errors)
+				}
+			}()
+		}
+		wg.Wait()
+	})
+}
@@ -0,0 +1,98 @@
+package gronx
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// CronSet is a union of cron expressions which is due whenever any one of them is due.
+// It doesn't share any state, so it is safe to use from many goroutines.
+type CronSet struct {
+	exprs []string
+	es    []*Expression
+}
+
+// NewCronSet initializes CronSet with given cron expressions compiled with factory defaults
+// like Parse, each in its own location as per CRON_TZ= prefix if any.
+// It returns error identifying the member by index if any expr is invalid.
+func NewCronSet(exprs ...string) (*CronSet, error) {
+	set, gron := &CronSet{exprs: exprs, es: make([]*Expression, len(exprs))}, New()
+	for i, expr := range exprs {
+		e, err := gron.Parse(expr)
+		if err == nil {
+			err = gron.Validate(expr)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid cron expression #%d %q: %w", i, expr, err)
+		}
+
+		set.es[i] = e
+	}
+
+	return set, nil
+}
+
+// IsDue checks if any cron expression in the set is due for given time.
+// It returns bool or error if any.
+func (s *CronSet) IsDue(t time.Time) (bool, error) {
+	for i, e := range s.es {
+		due, err := e.isDue(t, nil)
+		if err != nil {
+			return false, s.memberErr(i, err)
+		}
+		if due {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// Next gets the earliest next due time among cron expressions in the set on or after t, in the
+// location of the member it is of. Each member is only searched until the earliest found so far.
+func (s *CronSet) Next(t time.Time) (time.Time, error) {
+	t = t.Round(0)
+	limit, found := searchLimit(t, defaultYears, false), false
+	for i, e := range s.es {
+		next, ok, err := e.nextUntil(t, limit, nil)
+		if err != nil {
+			return time.Time{}, s.memberErr(i, err)
+		}
+		if ok {
+			limit, found = next, true
+		}
+	}
+
+	if !found {
+		return time.Time{}, &SearchError{Expr: strings.Join(s.exprs, ", "), Ref: t, Year: limit.Year()}
+	}
+
+	return limit, nil
+}
+
+// Prev gets the latest previous due time among cron expressions in the set on or before t, in
+// the location of the member it is of. Each member is only searched until the latest found so far.
+func (s *CronSet) Prev(t time.Time) (time.Time, error) {
+	t = t.Round(0)
+	limit, found := searchLimit(t, defaultYears, true), false
+	for i, e := range s.es {
+		prev, ok, err := e.prevUntil(t, limit, nil)
+		if err != nil {
+			return time.Time{}, s.memberErr(i, err)
+		}
+		if ok {
+			limit, found = prev, true
+		}
+	}
+
+	if !found {
+		return time.Time{}, &SearchError{Expr: strings.Join(s.exprs, ", "), Ref: t, Year: limit.Year(), Prev: true}
+	}
+
+	return limit, nil
+}
+
+func (s *CronSet) memberErr(i int, err error) error {
+	return fmt.Errorf("cron expression #%d %q: %w", i, s.exprs[i], err)
+}
@@ -0,0 +1,133 @@
+package gronx
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCronSet(t *testing.T) {
+	set, err := NewCronSet("0 9 * * MON-FRI", "0 12 * * SAT")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	t.Run("set is due", func(t *testing.T) {
+		tests := map[string]bool{
+			"2021-04-19 09:00:00": true,
+			"2021-04-24 12:00:00": true,
+			"2021-04-24 09:00:00": false,
+			"2021-04-25 12:00:00": false,
+		}
+		for ref, expect := range tests {
+			at, _ := time.Parse(dateFormat, ref)
+			if actual, err := set.IsDue(at); err != nil || actual != expect {
+				t.Errorf("%s: expected %v, got %v, %v", ref, expect, actual, err)
+			}
+		}
+	})
+
+	t.Run("set next", func(t *testing.T) {
+		tests := map[string]string{
+			"2021-04-23 09:01:00": "2021-04-24 12:00:00",
+			"2021-04-24 12:01:00": "2021-04-26 09:00:00",
+			"2021-04-19 09:00:00": "2021-04-19 09:00:00",
+		}
+		for ref, expect := range tests {
+			at, _ := time.Parse(dateFormat, ref)
+			if next, err := set.Next(at); err != nil || next.Format(dateFormat) != expect {
+				t.Errorf("%s: expected %v, got %v, %v", ref, expect, next, err)
+			}
+		}
+	})
+
+	t.Run("set prev", func(t *testing.T) {
+		tests := map[string]string{
+			"2021-04-25 12:00:00": "2021-04-24 12:00:00",
+			"2021-04-24 11:59:00": "2021-04-23 09:00:00",
+		}
+		for ref, expect := range tests {
+			at, _ := time.Parse(dateFormat, ref)
+			if prev, err := set.Prev(at); err != nil || prev.Format(dateFormat) != expect {
+				t.Errorf("%s: expected %v, got %v, %v", ref, expect, prev, err)
+			}
+		}
+	})
+
+	t.Run("set none", func(t *testing.T) {
+		set, _ := NewCronSet("* * * * * 2018", "0 0 30 2 *")
+		if _, err := set.Next(time.Now()); !errors.Is(err, ErrNoOccurrence) {
+			t.Errorf("expected ErrNoOccurrence, got %v", err)
+		}
+	})
+
+	t.Run("set dst", func(t *testing.T) {
+		loc, err := time.LoadLocation("America/New_York")
+		abort(err)
+
+		set, _ := NewCronSet("30 1 * * *", "30 2 * * *")
+		tests := []struct {
+			ref    time.Time
+			expect bool
+		}{
+			{time.Date(2024, 11, 3, 1, 30, 0, 0, loc), true},
+			{time.Date(2024, 11, 3, 1, 30, 0, 0, loc).Add(time.Hour), false},
+			{time.Date(2024, 3, 10, 3, 0, 0, 0, loc), true},
+			{time.Date(2024, 3, 10, 3, 30, 0, 0, loc), false},
+		}
+		for _, test := range tests {
+			if actual, err := set.IsDue(test.ref); err != nil || actual != test.expect {
+				t.Errorf("%s: expected %v, got %v, %v", test.ref, test.expect, actual, err)
+			}
+			if actual, _ := IsDue("30 1,2 * * *", test.ref); actual != test.expect {
+				t.Errorf("%s: expected %v as IsDue, got %v", test.ref, test.expect, actual)
+			}
+		}
+
+		ref := time.Date(2024, 3, 10, 1, 59, 0, 0, loc)
+		next, err := set.Next(ref)
+		if due, _ := set.IsDue(next); err != nil || !next.Equal(time.Date(2024, 3, 10, 3, 0, 0, 0, loc)) || !due {
+			t.Errorf("expected due at 03:00, got %v due %v, %v", next, due, err)
+		}
+	})
+
+	t.Run("set zone", func(t *testing.T) {
+		set, err := NewCronSet("CRON_TZ=Asia/Tokyo 30 9 * * *", "@every 90s")
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		ref := time.Date(2021, 4, 19, 0, 30, 0, 0, time.UTC)
+		if due, err := set.IsDue(ref); err != nil || !due {
+			t.Errorf("expected due at 09:30 in Tokyo, got %v, %v", due, err)
+		}
+		if due, err := set.IsDue(ref.Add(61 * time.Second)); err != nil || due {
+			t.Errorf("expected not due, got %v, %v", due, err)
+		}
+
+		next, err := set.Next(ref.Add(61 * time.Second))
+		if expect := ref.Add(90 * time.Second); err != nil || !next.Equal(expect) {
+			t.Errorf("expected %v, got %v, %v", expect, next, err)
+		}
+		prev, err := set.Prev(ref.Add(-time.Second))
+		if expect := ref.Add(-90 * time.Second); err != nil || !prev.Equal(expect) {
+			t.Errorf("expected %v, got %v, %v", expect, prev, err)
+		}
+
+		set, _ = NewCronSet("CRON_TZ=Asia/Tokyo 30 9 * * *")
+		next, err = set.Next(ref.Add(time.Minute))
+		if expect := ref.AddDate(0, 0, 1); err != nil || !next.Equal(expect) || next.Location().String() != "Asia/Tokyo" {
+			t.Errorf("expected %v in Asia/Tokyo, got %v, %v", expect, next, err)
+		}
+	})
+
+	t.Run("set invalid", func(t *testing.T) {
+		for _, exprs := range [][]string{{"* * * * *", "A-B * * * *"}, {"* * * * *", "@reboot"}, {"* * * * *", "CRON_TZ=Mars/Olympus * * * * *"}} {
+			_, err := NewCronSet(exprs...)
+			if err == nil || !strings.Contains(err.Error(), "#1") {
+				t.Errorf("expected error for member #1, got %v", err)
+			}
+		}
+	})
+}
@@ -0,0 +1,78 @@
+package gronx
+
+import "time"
+
+// IsDue checks if cron expression is due for given time without any shared state,
+// so it is safe to call from many goroutines. The seconds and nanoseconds of t are
+// ignored like Gronx.IsDue. It returns bool or error if any.
+func IsDue(expr string, t time.Time) (bool, error) {
+	expr, loc, err := exprZone(expr)
+	if err != nil {
+		return false, err
+	}
+
+	segs, err := Segments(expr)
+	if err != nil {
+		return false, err
+	}
+	t = inZone(t, loc)
+
+	pos, err := undueSegment(segs, t, checkDue)
+	if err != nil {
+		return false, err
+	}
+
+	return dstDue(segs, t, pos < 0, checkDue)
+}
+
+// NextTick gets the next due time for cron expression on or after given time without any
+// shared state, so it is safe to call from many goroutines. It returns time or error if any.
+func NextTick(expr string, t time.Time) (time.Time, error) {
+	expr, loc, err := exprZone(expr)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	segs, err := Segments(expr)
+	if err != nil {
+		return time.Time{}, err
+	}
+	t = inZone(t, loc)
+
+	limit := searchLimit(t, defaultYears, false)
+	next, ok, err := nextTime(segs, t, limit, checkDue)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if !ok {
+		return time.Time{}, &SearchError{Expr: expr, Ref: t, Year: limit.Year()}
+	}
+
+	return next, nil
+}
+
+// PrevTick gets the previous due time for cron expression on or before given time without any
+// shared state, so it is safe to call from many goroutines. It returns time or error if any.
+func PrevTick(expr string, t time.Time) (time.Time, error) {
+	expr, loc, err := exprZone(expr)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	segs, err := Segments(expr)
+	if err != nil {
+		return time.Time{}, err
+	}
+	t = inZone(t, loc)
+
+	limit := searchLimit(t, defaultYears, true)
+	prev, ok, err := prevTime(segs, t, limit, checkDue, nil)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if !ok {
+		return time.Time{}, &SearchError{Expr: expr, Ref: t, Year: limit.Year(), Prev: true}
+	}
+
+	return prev, nil
+}
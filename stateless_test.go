@@ -0,0 +1,78 @@
+package gronx
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestStateless(t *testing.T) {
+	gron := New()
+
+	t.Run("is due agrees", func(t *testing.T) {
+		for _, test := range testcases() {
+			if test.Ref == "" {
+				continue
+			}
+
+			ref, _ := time.Parse(dateFormat, test.Ref)
+			expect, _ := gron.IsDue(test.Expr, ref)
+			if actual, _ := IsDue(test.Expr, ref); actual != expect {
+				t.Errorf("%s at %s: expected %v, got %v", test.Expr, test.Ref, expect, actual)
+			}
+		}
+	})
+
+	t.Run("next tick agrees", func(t *testing.T) {
+		for _, test := range nextcases() {
+			if next, err := NextTick(test.Expr, test.ref()); err != nil || next.Format(dateFormat) != test.Expect {
+				t.Errorf("%s at %s: expected %v, got %v, %v", test.Expr, test.Ref, test.Expect, next, err)
+			}
+		}
+	})
+
+	t.Run("prev tick agrees", func(t *testing.T) {
+		for _, test := range prevcases() {
+			if prev, err := PrevTick(test.Expr, test.ref()); err != nil || prev.Format(dateFormat) != test.Expect {
+				t.Errorf("%s at %s: expected %v, got %v, %v", test.Expr, test.Ref, test.Expect, prev, err)
+			}
+		}
+	})
+
+	t.Run("stateless errors", func(t *testing.T) {
+		now := time.Now()
+		if _, err := IsDue("* * *", now); err == nil {
+			t.Errorf("expected error, got nil")
+		}
+		if _, err := NextTick("* * * * * 2018", now); !errors.Is(err, ErrNoOccurrence) {
+			t.Errorf("expected ErrNoOccurrence, got %v", err)
+		}
+		if _, err := PrevTick("* * * * * 2099", now); !errors.Is(err, ErrNoOccurrence) {
+			t.Errorf("expected ErrNoOccurrence, got %v", err)
+		}
+		if _, err := PrevTick("A-B * * * *", now); err == nil {
+			t.Errorf("expected error, got nil")
+		}
+	})
+
+	t.Run("stateless concurrent", func(t *testing.T) {
+		wg := sync.WaitGroup{}
+		for i := 0; i < 8; i++ {
+			wg.Add(1)
+			go func(hour int) {
+				defer wg.Done()
+				ref := time.Date(2021, 4, 19, hour, 0, 0, 0, time.UTC)
+				for j := 0; j < 100; j++ {
+					if due, _ := IsDue("0 * * * *", ref); !due {
+						t.Errorf("expected due at %v", ref)
+					}
+					if next, _ := NextTick("30 * * * *", ref); !next.Equal(ref.Add(30 * time.Minute)) {
+						t.Errorf("expected %v, got %v", ref.Add(30*time.Minute), next)
+					}
+				}
+			}(i)
+		}
+		wg.Wait()
+	})
+}
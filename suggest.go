@@ -0,0 +1,136 @@
+package gronx
+
+import (
+	"sort"
+	"strings"
+)
+
+// maxTypo is the largest edit distance of unknown name from known one to be suggested.
+const maxTypo = 2
+
+// MacroError is the error for unknown macro like @dialy, with the closest known macro as
+// Suggestion if any. It is ErrUnknownToken for errors.Is.
+type MacroError struct {
+	Macro      string
+	Suggestion string
+	// Reserved tells that Macro is the name RegisterMacro can't take, rather than unknown.
+	Reserved bool
+}
+
+func (e *MacroError) Error() string {
+	if e.Reserved {
+		return "macro name is reserved: " + e.Macro
+	}
+
+	msg := "unknown macro " + e.Macro
+	if e.Suggestion != "" {
+		msg += ", did you mean " + e.Suggestion + "?"
+	}
+
+	return msg
+}
+
+// Unwrap gives ErrUnknownToken, unless Macro is reserved.
+func (e *MacroError) Unwrap() error {
+	if e.Reserved {
+		return nil
+	}
+
+	return ErrUnknownToken
+}
+
+// macroErr gives MacroError if expr starts with @ but is not a known macro, @every or @reboot.
+func macroErr(expr string) error {
+	if !strings.HasPrefix(expr, "@") {
+		return nil
+	}
+
+	name := strings.ToLower(strings.Fields(expr)[0])
+	if name == "@every" || name == "@reboot" {
+		return nil
+	}
+	if _, ok := macro(name); ok {
+		return nil
+	}
+
+	names := []string{"@every", "@reboot"}
+	for name := range Macros() {
+		names = append(names, name)
+	}
+
+	return &MacroError{Macro: name, Suggestion: suggest(name, names)}
+}
+
+// nameSuggestion gives the known name closest to unknown name in segment at given position.
+func nameSuggestion(name string, pos int) string {
+	names := Bounds(pos).Names
+	list := make([]string, 0, len(names))
+	for name := range names {
+		list = append(list, name)
+	}
+
+	return suggest(name, list)
+}
+
+// suggest gives the one of names closest to given name to tell as typo of it, ie within maxTypo
+// edits and closer than half of its length. The first one in order wins the tie.
+func suggest(name string, names []string) string {
+	sort.Strings(names)
+	best, min := "", maxTypo+1
+	for _, known := range names {
+		if dist := editDistance(name, known); dist < min && dist*2 < len(known) {
+			best, min = known, dist
+		}
+	}
+
+	return best
+}
+
+// editDistance gives the Levenshtein distance of a and b, ie the number of runes to insert,
+// delete or substitute to turn a into b.
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		cur := make([]int, len(rb)+1)
+		cur[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			cur[j] = minInt(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev = cur
+	}
+
+	return prev[len(rb)]
+}
+
+// minInt gives the smallest of nums.
+func minInt(nums ...int) int {
+	min := nums[0]
+	for _, num := range nums[1:] {
+		if num < min {
+			min = num
+		}
+	}
+
+	return min
+}
+
+// maxInt gives the largest of nums.
+func maxInt(nums ...int) int {
+	max := nums[0]
+	for _, num := range nums[1:] {
+		if num > max {
+			max = num
+		}
+	}
+
+	return max
+}
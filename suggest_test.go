@@ -0,0 +1,65 @@
+package gronx
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestSuggestion(t *testing.T) {
+	tests := map[string]string{
+		"@dialy":         "@daily",
+		"@hourl":         "@hourly",
+		"@evrey 1h":      "@every",
+		"@rebot":         "@reboot",
+		"@xyz":           "",
+		"0 9 * * MOM":    "MON",
+		"0 9 * * FRU":    "FRI",
+		"0 9 * * TUESDY": "TUESDAY",
+		"0 9 * JAM *":    "JAN",
+		"0 9 * * XYZ":    "",
+	}
+
+	for expr, expect := range tests {
+		t.Run("suggestion "+expr, func(t *testing.T) {
+			err := Validate(expr)
+			if err == nil {
+				t.Fatalf("expected error, got nil")
+			}
+			if !errors.Is(err, ErrUnknownToken) {
+				t.Errorf("expected ErrUnknownToken, got %v", err)
+			}
+
+			var merr *MacroError
+			var ferr *FieldError
+			suggestion := ""
+			if errors.As(err, &merr) {
+				suggestion = merr.Suggestion
+			} else if errors.As(err, &ferr) {
+				suggestion = ferr.Suggestion
+			}
+			if suggestion != expect {
+				t.Errorf("expected suggestion %q, got %q", expect, suggestion)
+			}
+			if expect != "" && !strings.Contains(err.Error(), "did you mean "+expect+"?") {
+				t.Errorf("expected did you mean %s, got %v", expect, err)
+			}
+		})
+	}
+
+	t.Run("suggestion gronx", func(t *testing.T) {
+		gron := New(WithSecondsField())
+		var merr *MacroError
+		if _, err := gron.IsDue("@dialy"); !errors.As(err, &merr) || merr.Suggestion != "@daily" {
+			t.Errorf("expected @daily suggestion, got %v", err)
+		}
+	})
+
+	t.Run("edit distance", func(t *testing.T) {
+		for pair, expect := range map[[2]string]int{{"", "abc"}: 3, {"MOM", "MON"}: 1, {"dialy", "daily"}: 2, {"same", "same"}: 0} {
+			if actual := editDistance(pair[0], pair[1]); actual != expect {
+				t.Errorf("expected %d for %v, got %d", expect, pair, actual)
+			}
+		}
+	})
+}
@@ -0,0 +1,147 @@
+package gronx
+
+import (
+	"math/bits"
+	"time"
+)
+
+// minutesPerDay is the count of wall clock minutes in a day without DST transition.
+const minutesPerDay = 24 * 60
+
+// fireTable is the due minutes of day of the expression that is due every day alike, ie with
+// only minute and hour restricted, with bit n set for the minute n of day. So the due times are
+// looked up in it rather than searched for, unless there is DST transition around.
+type fireTable struct {
+	day [(minutesPerDay + 63) / 64]uint64
+}
+
+// newFireTable gives fireTable of e if it is due every day alike, or nil.
+func newFireTable(e *Expression) *fireTable {
+	segs := e.segs
+	if len(segs) > PosYear+1 {
+		return nil
+	}
+	for pos := PosDayOfMonth; pos < len(segs); pos++ {
+		if segs[pos] != "*" && segs[pos] != "?" {
+			return nil
+		}
+	}
+
+	var values [PosHour + 1]uint64
+	for pos := range values {
+		switch {
+		case segs[pos] == "*":
+			values[pos] = 1<<uint(bounds[pos][1]+1) - 1
+		case e.plain[pos] && e.values[pos] != 0:
+			values[pos] = e.values[pos]
+		default:
+			return nil
+		}
+	}
+
+	table := &fireTable{}
+	for hour := 0; hour < 24; hour++ {
+		for minute := 0; minute < 60; minute++ {
+			if values[PosHour]&(1<<uint(hour)) != 0 && values[PosMinute]&(1<<uint(minute)) != 0 {
+				n := hour*60 + minute
+				table.day[n/64] |= 1 << uint(n%64)
+			}
+		}
+	}
+
+	return table
+}
+
+// due checks if ref is due as per the table, and whether it could tell.
+func (t *fireTable) due(ref time.Time) (bool, bool) {
+	if t == nil || !steady(ref.Add(-24*time.Hour), ref) {
+		return false, false
+	}
+
+	n := minuteOfDay(ref)
+
+	return t.day[n/64]&(1<<uint(n%64)) != 0, true
+}
+
+// next gives the next due time on or after ref as per the table, and whether it could tell.
+func (t *fireTable) next(ref time.Time) (time.Time, bool) {
+	if t == nil {
+		return ref, false
+	}
+
+	ref = truncMinute(ref)
+	n := minuteOfDay(ref)
+	due := t.after(n)
+	if due < 0 {
+		// The first one tomorrow.
+		due = t.after(0) + minutesPerDay
+	}
+	next := ref.Add(time.Duration(due-n) * time.Minute)
+
+	return next, steady(ref.Add(-24*time.Hour), next)
+}
+
+// prev gives the previous due time on or before ref as per the table, and whether it could tell.
+func (t *fireTable) prev(ref time.Time) (time.Time, bool) {
+	if t == nil {
+		return ref, false
+	}
+
+	ref = truncMinute(ref)
+	n := minuteOfDay(ref)
+	due := t.before(n)
+	if due < 0 {
+		// The last one yesterday.
+		due = t.before(minutesPerDay-1) - minutesPerDay
+	}
+	prev := ref.Add(-time.Duration(n-due) * time.Minute)
+
+	return prev, steady(prev.Add(-24*time.Hour), ref)
+}
+
+// after gives the first due minute of day on or after n, or -1 if there is none.
+func (t *fireTable) after(n int) int {
+	for i := n / 64; i < len(t.day); i++ {
+		word := t.day[i]
+		if i == n/64 {
+			word &^= 1<<uint(n%64) - 1
+		}
+		if word != 0 {
+			return i*64 + bits.TrailingZeros64(word)
+		}
+	}
+
+	return -1
+}
+
+// before gives the last due minute of day on or before n, or -1 if there is none.
+func (t *fireTable) before(n int) int {
+	for i := n / 64; i >= 0; i-- {
+		word := t.day[i]
+		if i == n/64 && n%64 < 63 {
+			word &= 1<<uint(n%64+1) - 1
+		}
+		if word != 0 {
+			return i*64 + bits.Len64(word) - 1
+		}
+	}
+
+	return -1
+}
+
+// minuteOfDay gives the wall clock minute of day of ref.
+func minuteOfDay(ref time.Time) int {
+	hour, minute, _ := ref.Clock()
+
+	return hour*60 + minute
+}
+
+// steady checks if the zone offset is the same from one to the other that are under 2 days
+// apart, so the wall clock goes by the elapsed time between them without DST transition, as
+// there is at most one in between.
+func steady(from, to time.Time) bool {
+	_, fromOff := from.Zone()
+	_, toOff := to.Zone()
+
+	return fromOff == toOff
+}
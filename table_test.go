@@ -0,0 +1,106 @@
+package gronx
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFireTable(t *testing.T) {
+	t.Run("fire table class", func(t *testing.T) {
+		tests := map[string]bool{
+			"*/5 * * * *":      true,
+			"0 */2 * * *":      true,
+			"15,45 9-17 * * ?": true,
+			"* * * * *":        true,
+			"0 0 1 * *":        false,
+			"0 0 * * 1":        false,
+			"0 0 L * *":        false,
+			"0 0 * * * 2030":   false,
+			"@every 5m":        false,
+		}
+		gron, seconds := New(WithYearField()), New(WithSecondsField())
+		for expr, expect := range tests {
+			e, err := gron.Parse(expr)
+			abort(err)
+			if actual := e.table != nil; actual != expect {
+				t.Errorf("%s: expected table %v, got %v", expr, expect, actual)
+			}
+		}
+		if e, _ := seconds.Parse("0 */5 * * * *"); e.table != nil {
+			t.Errorf("expected no table with seconds")
+		}
+	})
+
+	t.Run("fire table same", func(t *testing.T) {
+		rnd := rand.New(rand.NewSource(87))
+		zones := []string{"UTC", "America/New_York", "Europe/Berlin", "Australia/Lord_Howe", "America/Havana", "Asia/Kathmandu"}
+		ref := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+		// The days around DST transitions are more likely.
+		days := []time.Time{
+			time.Date(2024, time.March, 10, 0, 0, 0, 0, time.UTC), time.Date(2024, time.November, 3, 0, 0, 0, 0, time.UTC),
+			time.Date(2024, time.March, 31, 0, 0, 0, 0, time.UTC), time.Date(2024, time.October, 6, 0, 0, 0, 0, time.UTC),
+		}
+		grons := []Gronx{New(), New(WithExclusive())}
+
+		for i := 0; i < 4000; i++ {
+			segs := []string{"*", "*", "*", "*", "*"}
+			for pos, limit := range [][2]int{{0, 59}, {0, 23}} {
+				if rnd.Intn(4) > 0 {
+					offsets := make([]string, 1+rnd.Intn(2))
+					for j := range offsets {
+						offsets[j] = randomOffset(rnd, limit[0], limit[1])
+					}
+					segs[pos] = strings.Join(offsets, ",")
+				}
+			}
+
+			gron, expr := grons[i%len(grons)], strings.Join(segs, " ")
+			e, err := gron.Parse(expr)
+			if err != nil {
+				continue
+			}
+			loc, err := time.LoadLocation(zones[rnd.Intn(len(zones))])
+			abort(err)
+			at := ref.Add(time.Duration(rnd.Int63n(int64(3 * 365 * 24 * time.Hour))))
+			if i%2 == 0 {
+				at = days[rnd.Intn(len(days))].Add(time.Duration(rnd.Int63n(int64(48 * time.Hour))))
+			}
+			at = at.In(loc)
+
+			due, derr := e.isDue(at, e.check)
+			if actual, err := e.isDue(at, nil); actual != due || err != derr {
+				t.Errorf("%s at %v: expected due %v, %v, got %v, %v", expr, at, due, derr, actual, err)
+			}
+			next, nerr := e.next(at, e.check)
+			if actual, err := e.next(at, nil); !actual.Equal(next) || (err == nil) != (nerr == nil) {
+				t.Errorf("%s at %v: expected next %v, %v, got %v, %v", expr, at, next, nerr, actual, err)
+			}
+			prev, perr := e.prev(at, e.check)
+			if actual, err := e.prev(at, nil); !actual.Equal(prev) || (err == nil) != (perr == nil) {
+				t.Errorf("%s at %v: expected prev %v, %v, got %v, %v", expr, at, prev, perr, actual, err)
+			}
+		}
+	})
+}
+
+func BenchmarkFireTable(b *testing.B) {
+	ref := time.Date(2024, time.June, 3, 9, 7, 0, 0, time.UTC)
+	for _, expr := range []string{"*/5 * * * *", "0 */2 * * *", "30 23 * * *"} {
+		e, err := Parse(expr)
+		abort(err)
+		for name, check := range map[string]dueFunc{"table": nil, "search": e.check} {
+			b.Run(name+" next "+expr, func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					_, _ = e.next(ref, check)
+				}
+			})
+			b.Run(name+" prev "+expr, func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					_, _ = e.prev(ref, check)
+				}
+			})
+		}
+	}
+}
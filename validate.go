@@ -0,0 +1,256 @@
+package gronx
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Validate checks if cron expr is valid without checking it for due at any time, ie the number of
+// segments, the syntax of each offset and that its values are within bounds of the segment.
+// The @reboot is valid but @every is not as it has no segments. It returns error if any, which is
+// ValidationErrors if many segments are invalid.
+func Validate(expr string) error {
+	expr, _, err := exprZone(expr)
+	if err != nil {
+		return err
+	}
+
+	return givenValidation(validate(parseSegments(expr)), givenSegments(expr, false))
+}
+
+// Validate checks if cron expr is valid as per the options of Gronx, like the stateless Validate.
+// The @reboot and @every are valid. It returns error if any.
+func (g *Gronx) Validate(expr string) error {
+	expr, _, err := exprZone(expr)
+	if err != nil {
+		return err
+	}
+	if _, ok, err := g.every(expr); ok {
+		return err
+	}
+
+	return givenValidation(validate(g.parse(expr)), givenSegments(expr, g.seconds))
+}
+
+// IsValidSegment checks if a single segment at given position (eg: PosMonth) is valid, as it
+// would be in expr with the same names, trimming and case. It returns error if any, which is
+// FieldError for the first invalid part of segment.
+func IsValidSegment(seg string, pos int) error {
+	if pos < PosMinute || pos > PosSecond {
+		return posErr(pos)
+	}
+
+	seg = strings.ToUpper(strings.Trim(seg, " \t"))
+	switch pos {
+	case PosMonth:
+		seg = replaceNames(seg, months)
+	case PosDayOfWeek:
+		seg = replaceNames(seg, days)
+	}
+
+	errs := parseErrs(seg, pos, defaultYears)
+	if len(errs) == 0 {
+		errs = offsetErrs(seg, pos)
+	}
+	if len(errs) > 0 {
+		return errs[0]
+	}
+
+	return nil
+}
+
+// ValidationErrors are the errors of all the invalid segments found by Validate, in order of
+// their position. Each of them is FieldError.
+type ValidationErrors []error
+
+func (e ValidationErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Error()
+	}
+
+	return strings.Join(msgs, "; ")
+}
+
+// Unwrap gives the errors, so that errors.Is and errors.As can match any of them.
+func (e ValidationErrors) Unwrap() []error {
+	return e
+}
+
+// Is checks if any of the errors matches target, as errors.Is only walks Unwrap of multiple
+// errors since Go 1.20.
+func (e ValidationErrors) Is(target error) bool {
+	for _, err := range e {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// As finds the first of the errors that matches target like errors.As, for the same reason as Is.
+func (e ValidationErrors) As(target interface{}) bool {
+	for _, err := range e {
+		if errors.As(err, target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// validate checks the offsets of parsed segs that have no error yet, and gives all the errors
+// in order of position if any. The segs are nil if expr as a whole is invalid, unless @reboot.
+func validate(segs []string, errs []error) error {
+	if segs == nil {
+		if errors.Is(errs[0], ErrReboot) {
+			return nil
+		}
+		return errs[0]
+	}
+
+	invalid := errPositions(errs)
+	for pos, seg := range segs {
+		if !invalid[pos] {
+			errs = append(errs, offsetErrs(seg, pos)...)
+		}
+	}
+
+	sort.SliceStable(errs, func(i, j int) bool {
+		return errPosition(errs[i]) < errPosition(errs[j])
+	})
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		return errs[0]
+	}
+
+	return ValidationErrors(errs)
+}
+
+// errPosition gives the position of segment that err is for, or -1 if it isn't a FieldError.
+func errPosition(err error) int {
+	var ferr *FieldError
+	if errors.As(err, &ferr) {
+		return ferr.Pos
+	}
+
+	return -1
+}
+
+// errPositions gives the positions of segments that errs are for.
+func errPositions(errs []error) map[int]bool {
+	invalid := map[int]bool{}
+	for _, err := range errs {
+		invalid[errPosition(err)] = true
+	}
+
+	return invalid
+}
+
+// validRef is the time to check day of month and week day modifiers with, for their syntax only.
+var validRef = time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// validSegment checks the syntax and bounds of each offset in segment at given position.
+// It returns the error of the first invalid offset if any.
+func validSegment(segment string, pos int) error {
+	if errs := offsetErrs(segment, pos); len(errs) > 0 {
+		return errs[0]
+	}
+
+	return nil
+}
+
+// offsetErrs gives the error of each invalid offset in segment at given position, once for
+// offsets given more than once.
+func offsetErrs(segment string, pos int) []error {
+	if segment == "*" || segment == "?" {
+		return nil
+	}
+
+	errs, offsets, seen := []error{}, strings.Split(segment, ","), map[string]bool{}
+	for i, offset := range offsets {
+		if seen[offset] {
+			continue
+		}
+		seen[offset] = true
+
+		var err error
+		reason := ReasonModifier
+		switch mod := strings.ContainsAny(offset, "LW#"); {
+		case mod && pos == PosDayOfMonth:
+			_, err = isValidMonthDay(offset, 31, validRef)
+		case mod && pos == PosDayOfWeek:
+			_, err = isValidWeekDay(offset, 31, validRef)
+		default:
+			reason, err = validOffset(offset, pos)
+		}
+		if err != nil {
+			errs = append(errs, fieldErr(pos, segment, reason, elementErr(offsets, i, err)))
+		}
+	}
+
+	return errs
+}
+
+// validOffset checks that offset is a value, range, value or range with step or * with step,
+// and that the values are within bounds of segment at given position. It returns the reason
+// and error if any.
+func validOffset(offset string, pos int) (string, error) {
+	parts := strings.Split(offset, "/")
+	if len(parts) > 2 {
+		return ReasonSyntax, errors.New("invalid step: " + offset)
+	}
+	if len(parts) == 2 {
+		if _, err := strconv.Atoi(parts[1]); err != nil {
+			return ReasonSyntax, errors.New("invalid step: " + offset)
+		}
+		if parts[0] == "*" {
+			return "", nil
+		}
+	}
+
+	min, max := valueBounds(pos)
+	values := strings.Split(parts[0], "-")
+	if len(values) > 2 {
+		return ReasonSyntax, errors.New("invalid range: " + offset)
+	}
+
+	nums := make([]int, 0, 2)
+	for _, value := range values {
+		num, err := strconv.Atoi(value)
+		if err != nil {
+			return ReasonSyntax, fmt.Errorf("invalid value %q in %s segment: %s", value, segmentNames[pos], offset)
+		}
+		// The years are checked in parsing, as per WithYearBounds.
+		if pos != PosYear && (num < min || num > max) {
+			return ReasonOutOfRange, fmt.Errorf("value %d in %s segment should be %d-%d: %s", num, segmentNames[pos], min, max, offset)
+		}
+		nums = append(nums, num)
+	}
+	if len(nums) > 1 && nums[0] > nums[1] && pos == PosYear {
+		return ReasonSyntax, errors.New("range can't wrap around in unbounded segment: " + offset)
+	}
+
+	return "", nil
+}
+
+// valueBounds gives the min and max values allowed in segment at given position, with the
+// default years for year segment.
+func valueBounds(pos int) (int, int) {
+	switch pos {
+	case PosYear:
+		return minYear, maxYear
+	case PosDayOfWeek:
+		// Both 0 and 7 are sunday.
+		return 0, 7
+	}
+
+	return bounds[pos][0], bounds[pos][1]
+}
@@ -0,0 +1,201 @@
+package gronx
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	for _, expr := range []string{
+		"* * * * *", "0 0 L * *", "0 0 LW * *", "0 0 L-3 * *", "0 0 15W * *", "0 0 * * 5L", "0 0 * * 1#3",
+		"0-59/5 * * * *", "0 22-2 * * *", "0 0 * * 7", "0 0 * * 5-7", "0 0 1 1 * 2099", "0 0 1 1 * 2025/2",
+		"5,10-20/2,*/15 * * * *", "0 0 ? * MON-FRI", "@reboot", "@daily",
+	} {
+		t.Run("validate "+expr, func(t *testing.T) {
+			if err := Validate(expr); err != nil {
+				t.Errorf("expected no error, got %v", err)
+			}
+		})
+	}
+
+	tests := map[string]string{
+		"61 * * * *":            "value 61 in minute segment should be 0-59",
+		"0 25 * * *":            "value 25 in hour segment should be 0-23",
+		"0 0 0 * *":             "value 0 in day of month segment should be 1-31",
+		"0 0 * 13 *":            "value 13 in month segment should be 1-12",
+		"0 0 * 0-12 *":          "value 0 in month segment should be 1-12",
+		"0 0 * * 8":             "value 8 in week day segment should be 0-7",
+		"0 0 1 1 * 2100":        "year should be 1970-2099",
+		"0 0 1 1 * 2030-2025":   "year range can't be inverted",
+		"1,* * * * *":           `invalid list element #1 "*"`,
+		"1-2-3 * * * *":         "invalid range",
+		"1/2/3 * * * *":         "invalid step",
+		"A * * * *":             `invalid value "A" in minute segment`,
+		"5-70/5 * * * *":        "value 70 in minute segment should be 0-59",
+		"0 0 32W * *":           "invalid nearest weekday of month",
+		"0 0 L-31 * *":          "offset from last day of month should be 0-30",
+		"0 0 * * 8L":            "invalid last weekday of month",
+		"0 0 * * 1#6":           "nth of weekday should be 1-5",
+		"0 0 1,15,L5 * *":       `invalid list element #2 "L5"`,
+		"@every 1h":             "@every",
+		"* * * *":               "5-6 segments",
+		"0 0 * * * * *":         "WithQuartz",
+		"0 0 1 1 * 2030,2020/X": "invalid step",
+	}
+	for expr, expect := range tests {
+		t.Run("validate err "+expr, func(t *testing.T) {
+			if err := Validate(expr); err == nil || !strings.Contains(err.Error(), expect) {
+				t.Errorf("expected %s, got %v", expect, err)
+			}
+		})
+	}
+
+	t.Run("validate gronx", func(t *testing.T) {
+		gron := New()
+		if err := gron.Validate("@every 90m"); err != nil {
+			t.Errorf("expected no error, got %v", err)
+		}
+		if err := gron.Validate("H * * * *"); err == nil {
+			t.Errorf("expected error without hash key, got nil")
+		}
+		if gron.IsValid("1,* * * * *") || gron.IsValid("61 * * * *") {
+			t.Errorf("expected false, got true")
+		}
+
+		hashed := New(WithHashKey("job"))
+		if err := hashed.Validate("H H(0-5) * * *"); err != nil {
+			t.Errorf("expected no error, got %v", err)
+		}
+
+		iso := New(WithISOWeekday())
+		if err := iso.Validate("0 0 * * 0"); err == nil {
+			t.Errorf("expected error in ISO mode, got nil")
+		}
+
+		secs := New(WithSecondsField())
+		if err := secs.Validate("60 * * * * *"); err == nil || !strings.Contains(err.Error(), "second segment") {
+			t.Errorf("expected second segment error, got %v", err)
+		}
+	})
+}
+
+func TestIsValidSegment(t *testing.T) {
+	tests := []struct {
+		seg    string
+		pos    int
+		expect string
+	}{
+		{"*/15", PosMinute, ""},
+		{" 5,10-20/2 ", PosMinute, ""},
+		{"61", PosMinute, "value 61 in minute segment should be 0-59"},
+		{"22-2", PosHour, ""},
+		{"L-3", PosDayOfMonth, ""},
+		{"15w", PosDayOfMonth, ""},
+		{"32W", PosDayOfMonth, "invalid nearest weekday of month"},
+		{"jan-Jun/2", PosMonth, ""},
+		{"JAN", PosDayOfWeek, `month name "JAN" is not allowed in week day segment`},
+		{"mon-fri", PosDayOfWeek, ""},
+		{"5L", PosDayOfWeek, ""},
+		{"FRU", PosDayOfWeek, `unknown name "FRU" in week day segment`},
+		{"2025/2", PosYear, ""},
+		{"2100", PosYear, "year should be 1970-2099"},
+		{"*/75", PosSecond, "step 75 in second segment should be 1-60"},
+		{"", PosMinute, `invalid value ""`},
+		{"5", 9, "invalid segment position 9"},
+	}
+
+	for _, test := range tests {
+		t.Run("is valid segment "+test.seg, func(t *testing.T) {
+			err := IsValidSegment(test.seg, test.pos)
+			if test.expect == "" && err != nil {
+				t.Errorf("expected no error, got %v", err)
+			}
+			if test.expect != "" && (err == nil || !strings.Contains(err.Error(), test.expect)) {
+				t.Errorf("expected %s, got %v", test.expect, err)
+			}
+		})
+	}
+
+	t.Run("is valid segment field error", func(t *testing.T) {
+		var ferr *FieldError
+		if err := IsValidSegment("13", PosMonth); !errors.As(err, &ferr) || ferr.Pos != PosMonth || ferr.Reason != ReasonOutOfRange {
+			t.Errorf("expected out of range FieldError at month, got %v", err)
+		}
+	})
+
+	t.Run("is valid segment as expr", func(t *testing.T) {
+		for pos, segs := range [][]string{{"0", "60", "1-5/2"}, {"23", "24", "*/25"}, {"L", "0", "LW"}, {"DEC", "MON", "0"}, {"7", "8", "SUN#2"}, {"2099", "1969", "2030-2025"}} {
+			for _, seg := range segs {
+				expr := []string{"0", "0", "1", "1", "*", "2025"}
+				expr[pos] = seg
+				if err, full := IsValidSegment(seg, pos), Validate(strings.Join(expr, " ")); (err == nil) != (full == nil) {
+					t.Errorf("expected same validity for %s, got %v and %v", seg, err, full)
+				}
+			}
+		}
+	})
+}
+
+func TestValidateMany(t *testing.T) {
+	t.Run("validate many", func(t *testing.T) {
+		err := Validate("75 0 * * FRU")
+
+		var verrs ValidationErrors
+		if !errors.As(err, &verrs) || len(verrs) != 2 {
+			t.Fatalf("expected 2 errors, got %v", err)
+		}
+		expect := `value 75 in minute segment should be 0-59: 75; unknown name "FRU" in week day segment: FRU, did you mean FRI?`
+		if err.Error() != expect {
+			t.Errorf("expected %s, got %s", expect, err.Error())
+		}
+
+		var ferr *FieldError
+		if !errors.As(err, &ferr) || ferr.Pos != PosMinute {
+			t.Errorf("expected first FieldError at minute, got %v", ferr)
+		}
+	})
+
+	tests := map[string][]int{
+		"75 25 0 13 8 2100":      {PosMinute, PosHour, PosDayOfMonth, PosMonth, PosDayOfWeek, PosYear},
+		"0 0 * SUN */0":          {PosMonth, PosDayOfWeek},
+		"70,70,75 * * * X":       {PosMinute, PosMinute, PosDayOfWeek},
+		"5,A,70 * 1,15,L5 JAN *": {PosMinute, PosDayOfMonth},
+		"5,A * 1,15,L5 JAN *":    {PosMinute, PosDayOfMonth},
+		"61 * * * *":             {PosMinute},
+	}
+	for expr, expect := range tests {
+		t.Run("validate many "+expr, func(t *testing.T) {
+			err := Validate(expr)
+
+			var errs []error
+			var verrs ValidationErrors
+			if errors.As(err, &verrs) {
+				errs = verrs
+			} else if err != nil {
+				errs = []error{err}
+			}
+			if len(errs) != len(expect) {
+				t.Fatalf("expected %d errors, got %v", len(expect), err)
+			}
+			for i, err := range errs {
+				if pos := errPosition(err); pos != expect[i] {
+					t.Errorf("expected error #%d at %d, got %d: %v", i, expect[i], pos, err)
+				}
+			}
+		})
+	}
+
+	t.Run("validate many gronx", func(t *testing.T) {
+		gron := New(WithSecondsField())
+		err := gron.Validate("75 0 0 * * FRU")
+
+		var verrs ValidationErrors
+		if !errors.As(err, &verrs) || len(verrs) != 2 || errPosition(verrs[1]) != PosSecond {
+			t.Errorf("expected week day and second errors, got %v", err)
+		}
+		if _, err := gron.IsDue("75 0 0 * * FRU"); errors.As(err, &verrs) {
+			t.Errorf("expected single error from IsDue, got %v", err)
+		}
+	})
+}
@@ -2,12 +2,16 @@ package gronx
 
 import (
 	"errors"
+	"fmt"
+	"regexp"
 	"strconv"
 	"strings"
 	"time"
 )
 
-func inStep(val int, s string) (bool, error) {
+// inStep checks if val is in step s, where */n counts from min value of the segment and
+// a range like 22-2/2 wraps around max.
+func inStep(val, min, max int, s string) (bool, error) {
 	parts := strings.Split(s, "/")
 	step, err := strconv.Atoi(parts[1])
 	if err != nil {
@@ -17,11 +21,15 @@ func inStep(val int, s string) (bool, error) {
 		return false, errors.New("step can't be 0")
 	}
 
-	if strings.Index(s, "*/") == 0 || strings.Index(s, "0/") == 0 {
-		return val%step == 0, nil
+	if strings.Index(s, "*/") == 0 {
+		return (val-min)%step == 0, nil
 	}
 
-	sub, end := strings.Split(parts[0], "-"), val
+	// A value with step like 5/10 is a range from that value until max of the segment.
+	sub, end := strings.Split(parts[0], "-"), max
+	if max == 0 {
+		end = val
+	}
 	start, err := strconv.Atoi(sub[0])
 	if err != nil {
 		return false, err
@@ -32,12 +40,16 @@ func inStep(val int, s string) (bool, error) {
 		if err != nil {
 			return false, err
 		}
+		if start > end {
+			return inWrapRange(val, start, end, min, max, step, s)
+		}
 	}
 
 	return inStepRange(val, start, end, step), nil
 }
 
-func inRange(val int, s string) (bool, error) {
+// inRange checks if val is in range s, which wraps around max if its start is after end.
+func inRange(val, min, max int, s string) (bool, error) {
 	parts := strings.Split(s, "-")
 	start, err := strconv.Atoi(parts[0])
 	if err != nil {
@@ -48,10 +60,25 @@ func inRange(val int, s string) (bool, error) {
 	if err != nil {
 		return false, err
 	}
+	if start > end {
+		return inWrapRange(val, start, end, min, max, 1, s)
+	}
 
 	return start <= val && val <= end, nil
 }
 
+// inWrapRange checks if val is in every step from start through max and then from min until end.
+func inWrapRange(val, start, end, min, max, step int, s string) (bool, error) {
+	if max == 0 {
+		return false, errors.New("range can't wrap around in unbounded segment: " + s)
+	}
+
+	span := max - min + 1
+	dist := ((val-start)%span + span) % span
+
+	return dist <= ((end-start)%span+span)%span && dist%step == 0, nil
+}
+
 func inStepRange(val, start, end, step int) bool {
 	for i := start; i <= end && i <= val; i += step {
 		if i == val {
@@ -66,6 +93,21 @@ func isValidMonthDay(val string, last int, ref time.Time) (bool, error) {
 	if val == "L" {
 		return day == last, nil
 	}
+	if val == "LW" {
+		return day == lastWeekday(last, ref), nil
+	}
+	if strings.HasPrefix(val, "L-") {
+		// The months shorter than offset are skipped.
+		offset, err := strconv.Atoi(val[2:])
+		if err != nil || offset < 0 || offset > 30 {
+			return false, errors.New("offset from last day of month should be 0-30: " + val)
+		}
+
+		return day == last-offset, nil
+	}
+	if strings.HasPrefix(val, "L") {
+		return false, errors.New("L can't be combined with other value in day of month: " + val)
+	}
 
 	pos := strings.Index(val, "W")
 	if pos < 1 {
@@ -73,10 +115,11 @@ func isValidMonthDay(val string, last int, ref time.Time) (bool, error) {
 	}
 
 	nval, err := strconv.Atoi(val[0:pos])
-	if err != nil {
-		return false, err
+	if err != nil || nval < 1 || nval > 31 || pos != len(val)-1 {
+		return false, errors.New("invalid nearest weekday of month: " + val)
 	}
 
+	// Saturday falls back to friday and sunday moves on to monday, but never off the month.
 	for _, i := range []int{0, -1, 1, -2, 2} {
 		incr := i + nval
 		if incr > 0 && incr <= last {
@@ -92,13 +135,31 @@ func isValidMonthDay(val string, last int, ref time.Time) (bool, error) {
 	return false, nil
 }
 
+// lastWeekday gives the last day of month (MON-FRI) for ref, where last is the last day of month.
+func lastWeekday(last int, ref time.Time) int {
+	switch time.Date(ref.Year(), ref.Month(), last, 0, 0, 0, 0, ref.Location()).Weekday() {
+	case time.Saturday:
+		return last - 1
+	case time.Sunday:
+		return last - 2
+	}
+
+	return last
+}
+
 func isValidWeekDay(val string, last int, ref time.Time) (bool, error) {
 	loc := ref.Location()
-	if pos := strings.Index(strings.ReplaceAll(val, "7L", "0L"), "L"); pos > 0 {
+	if pos := strings.Index(val, "L"); pos > 0 {
 		nval, err := strconv.Atoi(val[0:pos])
 		if err != nil {
 			return false, err
 		}
+		if nval < 0 || nval > 7 || pos != len(val)-1 {
+			return false, errors.New("invalid last weekday of month: " + val)
+		}
+
+		// Both 0 and 7 are sunday.
+		nval %= 7
 
 		for i := 0; i < 7; i++ {
 			decr := last - i
@@ -113,24 +174,144 @@ func isValidWeekDay(val string, last int, ref time.Time) (bool, error) {
 	}
 
 	pos := strings.Index(val, "#")
-	parts := strings.Split(strings.ReplaceAll(val, "7#", "0#"), "#")
-	if pos < 1 || len(parts) < 2 {
+	parts := strings.Split(val, "#")
+	if pos < 1 || len(parts) != 2 {
 		return false, errors.New("invalid offset value: " + val)
 	}
 
 	day, err := strconv.Atoi(parts[0])
 	if err != nil {
-		return false, err
+		return false, errors.New("invalid nth weekday of month: " + val)
+	}
+	if day < 0 || day > 7 {
+		return false, errors.New("nth weekday should be 0-7: " + val)
 	}
 
 	nth, err := strconv.Atoi(parts[1])
-	if err != nil {
-		return false, err
+	if err != nil || nth < 1 || nth > 5 {
+		return false, errors.New("nth of weekday should be 1-5: " + val)
 	}
 
-	if day < 0 || day > 7 || nth < 1 || nth > 5 || int(ref.Weekday()) != day {
+	// Both 0 and 7 are sunday.
+	if int(ref.Weekday()) != day%7 {
 		return false, nil
 	}
 
-	return ref.Day()/7 == nth-1, nil
+	return (ref.Day()-1)/7 == nth-1, nil
+}
+
+// segmentNames are the names of segments by position.
+var segmentNames = []string{"minute", "hour", "day of month", "month", "week day", "year", "second"}
+
+// validSteps checks that the steps in segment at given position are positive and not more than
+// the span of segment (except year), so they are due at least once per span.
+func validSteps(seg string, pos int) error {
+	for _, offset := range strings.Split(seg, ",") {
+		parts := strings.Split(offset, "/")
+		if len(parts) < 2 {
+			continue
+		}
+
+		step, err := strconv.Atoi(parts[len(parts)-1])
+		if err != nil {
+			continue
+		}
+
+		span := 0
+		if pos != PosYear {
+			span = bounds[pos][1] - bounds[pos][0] + 1
+		}
+		if step < 1 || (span > 0 && step > span) {
+			err := fmt.Errorf("step %d in %s segment should be 1-%d: %s", step, segmentNames[pos], span, seg)
+			if span == 0 {
+				err = fmt.Errorf("step %d in %s segment should be positive: %s", step, segmentNames[pos], seg)
+			}
+			return fieldErr(pos, seg, ReasonStep, err)
+		}
+	}
+
+	return nil
+}
+
+// numericRe is regex for offset of only value, range and step.
+var numericRe = regexp.MustCompile(`^(\*|\d+(-\d+)?)(/\d+)?$`)
+
+// outOfRange checks that the values, range ends and values with step in segment at given position
+// are within bounds of segment. The offsets with names, modifiers, H, R or ~ are left to others.
+// It returns the error of each such offset, once for offsets given more than once.
+func outOfRange(seg string, pos int) []error {
+	min, max := valueBounds(pos)
+	errs, offsets, seen := []error{}, strings.Split(seg, ","), map[string]bool{}
+	for i, offset := range offsets {
+		if seen[offset] || !numericRe.MatchString(offset) {
+			continue
+		}
+		seen[offset] = true
+
+		for _, value := range strings.Split(strings.Split(offset, "/")[0], "-") {
+			if num, err := strconv.Atoi(value); err == nil && (num < min || num > max) {
+				err := fmt.Errorf("value %d in %s segment should be %d-%d: %s", num, segmentNames[pos], min, max, offset)
+				errs = append(errs, fieldErr(pos, seg, ReasonOutOfRange, elementErr(offsets, i, err)))
+				break
+			}
+		}
+	}
+
+	return errs
+}
+
+// minYear and maxYear are the default bounds of years given in year segment.
+const minYear, maxYear = 1970, 2099
+
+// yearRange is the inclusive bounds of years, as given in year segment or searched for due time.
+type yearRange struct {
+	min, max int
+}
+
+// defaultYears are the bounds of year segment unless WithYearBounds, and anyYears are the
+// years time.Time can tell for checking segments already validated.
+var defaultYears, anyYears = yearRange{minYear, maxYear}, yearRange{0, 9999}
+
+// yearSpan gets the earliest and latest years of year segment validating them within years.
+// It returns them, whether the segment is bounded by given years at all and error if any.
+func yearSpan(segment string, years yearRange) (int, int, bool, error) {
+	lo, hi, bounded := years.max, years.min, true
+	for _, offset := range strings.Split(segment, ",") {
+		parts := strings.Split(offset, "/")
+		value := strings.Split(parts[0], "-")
+
+		nums := make([]int, 0, 2)
+		for _, v := range value {
+			year, err := strconv.Atoi(v)
+			if err != nil {
+				bounded = false
+				break
+			}
+			if year < years.min || year > years.max {
+				err := fmt.Errorf("year should be %d-%d: %s", years.min, years.max, segment)
+				return 0, 0, false, fieldErr(PosYear, segment, ReasonOutOfRange, err)
+			}
+			nums = append(nums, year)
+		}
+		if len(nums) != len(value) {
+			continue
+		}
+		if len(nums) > 1 && nums[0] > nums[1] {
+			err := errors.New("year range can't be inverted: " + segment)
+			return 0, 0, false, fieldErr(PosYear, segment, ReasonSyntax, err)
+		}
+
+		end := nums[len(nums)-1]
+		if len(nums) == 1 && len(parts) > 1 {
+			end = years.max
+		}
+		if nums[0] < lo {
+			lo = nums[0]
+		}
+		if end > hi {
+			hi = end
+		}
+	}
+
+	return lo, hi, bounded, nil
 }
@@ -0,0 +1,98 @@
+package gronx
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// zonePrefixes are the prefixes of expr that tell the time zone it is in, as exported from
+// Kubernetes, robfig/cron and the like, eg: CRON_TZ=Asia/Kolkata 30 9 * * *.
+var zonePrefixes = []string{"CRON_TZ=", "TZ="}
+
+// zones are the locations loaded by zone name, as loading reads the time zone database.
+var zones sync.Map
+
+// ZoneError is the error for unknown time zone in CRON_TZ= or TZ= prefix of cron expression.
+// It is ErrUnknownToken for errors.Is, and also the error of loading the zone.
+type ZoneError struct {
+	Zone string
+	Err  error
+}
+
+func (e *ZoneError) Error() string {
+	return fmt.Sprintf("unknown time zone %q in cron expression: %v", e.Zone, e.Err)
+}
+
+// Unwrap gives the error of loading the zone.
+func (e *ZoneError) Unwrap() error {
+	return e.Err
+}
+
+// Is checks if target is ErrUnknownToken.
+func (e *ZoneError) Is(target error) bool {
+	return target == ErrUnknownToken
+}
+
+// exprZone splits the CRON_TZ=<zone> or TZ=<zone> prefix off expr if any. It returns expr
+// without prefix, the location of zone (nil without prefix) and ZoneError if it is unknown.
+func exprZone(expr string) (string, *time.Location, error) {
+	trimmed := strings.Trim(expr, " \t")
+	upper := strings.ToUpper(trimmed)
+	for _, prefix := range zonePrefixes {
+		if !strings.HasPrefix(upper, prefix) {
+			continue
+		}
+
+		fields := SpaceRe.Split(trimmed, 2)
+		if len(fields) < 2 {
+			fields = append(fields, "")
+		}
+		loc, err := loadZone(fields[0][len(prefix):])
+
+		return fields[1], loc, err
+	}
+
+	return expr, nil, nil
+}
+
+// zone splits the time zone prefix off expr like exprZone, with the location of Gronx if expr
+// has no prefix.
+func (g *Gronx) zone(expr string) (string, *time.Location, error) {
+	expr, loc, err := exprZone(expr)
+	if loc == nil && err == nil {
+		loc = g.loc
+	}
+
+	return expr, loc, err
+}
+
+// loadZone loads the location of zone by name, once per name.
+func loadZone(name string) (*time.Location, error) {
+	if loc, ok := zones.Load(name); ok {
+		return loc.(*time.Location), nil
+	}
+	if name == "" {
+		return nil, &ZoneError{Zone: name, Err: errors.New("time zone name is empty")}
+	}
+
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, &ZoneError{Zone: name, Err: err}
+	}
+	zones.Store(name, loc)
+
+	return loc, nil
+}
+
+// inZone gives ref in loc, or in its own location if loc is nil, always without monotonic clock
+// reading (eg: of time.Now()) so that the times found from it compare by wall clock.
+func inZone(ref time.Time, loc *time.Location) time.Time {
+	if loc == nil {
+		return ref.Round(0)
+	}
+
+	return ref.In(loc)
+}
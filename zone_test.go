@@ -0,0 +1,76 @@
+package gronx
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestExprZone(t *testing.T) {
+	kolkata, err := time.LoadLocation("Asia/Kolkata")
+	abort(err)
+	ref := time.Date(2021, time.April, 19, 4, 0, 0, 0, time.UTC)
+
+	t.Run("zone is due", func(t *testing.T) {
+		gron := New()
+		for _, expr := range []string{"CRON_TZ=Asia/Kolkata 30 9 * * *", "TZ=Asia/Kolkata 30 9 * * *", "  cron_tz=Asia/Kolkata  30 9 * * *"} {
+			if due, err := gron.IsDue(expr, ref); err != nil || !due {
+				t.Errorf("%s: expected due at %v, got %v, %v", expr, ref, due, err)
+			}
+			if due, err := IsDue(expr, ref); err != nil || !due {
+				t.Errorf("%s: expected stateless due at %v, got %v, %v", expr, ref, due, err)
+			}
+		}
+		if due, _ := gron.IsDue("30 9 * * *", ref); due {
+			t.Errorf("expected not due without zone, got due")
+		}
+	})
+
+	t.Run("zone next prev", func(t *testing.T) {
+		gron := New()
+		next, err := gron.GetNext("CRON_TZ=Asia/Kolkata 30 9 * * *", ref.Add(time.Minute))
+		if expect := time.Date(2021, time.April, 20, 9, 30, 0, 0, kolkata); err != nil || !next.Equal(expect) || next.Location().String() != "Asia/Kolkata" {
+			t.Errorf("expected %v in Asia/Kolkata, got %v, %v", expect, next, err)
+		}
+
+		prev, err := gron.GetPrev("TZ=Asia/Kolkata 30 9 * * *", ref.Add(-time.Minute))
+		if expect := time.Date(2021, time.April, 18, 9, 30, 0, 0, kolkata); err != nil || !prev.Equal(expect) || prev.Location().String() != "Asia/Kolkata" {
+			t.Errorf("expected %v in Asia/Kolkata, got %v, %v", expect, prev, err)
+		}
+
+		tick, err := NextTick("CRON_TZ=Asia/Kolkata 0 0 * * *", ref)
+		if expect := time.Date(2021, time.April, 20, 0, 0, 0, 0, kolkata); err != nil || !tick.Equal(expect) {
+			t.Errorf("expected %v, got %v, %v", expect, tick, err)
+		}
+	})
+
+	t.Run("zone every", func(t *testing.T) {
+		gron := New()
+		if err := gron.Validate("CRON_TZ=UTC @every 1h"); err != nil {
+			t.Errorf("expected no error, got %v", err)
+		}
+		if err := Validate("TZ=UTC @daily"); err != nil {
+			t.Errorf("expected no error, got %v", err)
+		}
+	})
+
+	t.Run("zone unknown", func(t *testing.T) {
+		gron := New()
+		for _, expr := range []string{"CRON_TZ=Mars/Olympus 0 0 * * *", "TZ= 0 0 * * *"} {
+			err := Validate(expr)
+
+			var zerr *ZoneError
+			if !errors.As(err, &zerr) || !errors.Is(err, ErrUnknownToken) {
+				t.Errorf("%s: expected ZoneError, got %v", expr, err)
+			}
+			if _, err := gron.GetNext(expr, ref); !errors.As(err, &zerr) {
+				t.Errorf("%s: expected ZoneError, got %v", expr, err)
+			}
+		}
+
+		_, err := IsDue("CRON_TZ=Mars/Olympus 0 0 * * *", ref)
+		if expect := `unknown time zone "Mars/Olympus" in cron expression: unknown time zone Mars/Olympus`; err == nil || err.Error() != expect {
+			t.Errorf("expected %s, got %v", expect, err)
+		}
+	})
+}